@@ -20,7 +20,7 @@ struct MockTodoService: TodoServiceProtocol {
 
     func update(
         _ todo: Todo, title: String?, descriptionText: String?,
-        priority: Priority?, dueDate: Date??,
+        priority: Priority?, startDate: Date??, dueDate: Date??,
         project: Project??, tags: [Tag]?
     ) {}
 
@@ -29,16 +29,28 @@ struct MockTodoService: TodoServiceProtocol {
     func toggleComplete(_ todo: Todo) {}
     func softDelete(_ todo: Todo) {}
     func restore(_ todo: Todo) {}
+    func permanentlyDelete(_ todo: Todo) {}
+    func setKind(_ todo: Todo, kind: TodoKind) {}
+    func linkJira(_ todo: Todo, ticketID: String, serverURL: String) {}
+    func addBlocker(_ blocker: Todo, to todo: Todo) throws {}
+    func removeBlocker(_ blocker: Todo, from todo: Todo) {}
+    func snooze(_ todo: Todo, until date: Date?) {}
+    func archive(_ todo: Todo) {}
+    func unarchive(_ todo: Todo) {}
+    func archiveCompleted(olderThanDays days: Int) throws -> Int { 0 }
     func purgeExpired() throws -> Int { 0 }
+    func purgeArchived(retentionDays: Int) throws -> Int { 0 }
 
     func list(
         project: Project?, tag: Tag?, priority: Priority?,
-        isCompleted: Bool?, searchText: String, includeTrashed: Bool
+        isCompleted: Bool?, kind: TodoKind?, isSnoozed: Bool?, searchText: String,
+        includeTrashed: Bool, includeArchived: Bool
     ) throws -> [Todo] {
         todosToReturn
     }
 
     func listTrashed() throws -> [Todo] { trashedToReturn }
+    func listArchived() throws -> [Todo] { [] }
     func reorder(_ todo: Todo, newSortOrder: Int) {}
 }
 
@@ -52,12 +64,16 @@ struct MockProjectService: ProjectServiceProtocol {
     }
 
     func update(
-        _ project: Project, name: String?, color: String?,
+        _ project: Project, name: String?, color: String?, icon: String?,
         descriptionText: String?
     ) throws {}
 
     func delete(_ project: Project) {}
+    func restore(_ project: Project) throws {}
+    func permanentlyDelete(_ project: Project) {}
+    func purgeExpired() throws -> Int { 0 }
     func list() throws -> [Project] { projectsToReturn }
+    func listTrashed() throws -> [Project] { [] }
 }
 
 struct MockTagService: TagServiceProtocol {
@@ -147,6 +163,12 @@ actor MockExportService: ExportServiceProtocol {
         exportResultToReturn
     }
 
+    func generateWorklogs(
+        for date: Date, roundingMinutes: Int
+    ) throws -> [WorklogDraft] {
+        []
+    }
+
     func checkDuplicates(entryIDs: [UUID]) throws -> [UUID] { [] }
 
     func confirmExport(
@@ -156,6 +178,7 @@ actor MockExportService: ExportServiceProtocol {
     }
 
     func markBooked(exportID: PersistentIdentifier) throws {}
+    func markBooked(entryIDs: [UUID]) throws {}
 }
 
 actor MockLearnedPatternService: LearnedPatternServiceProtocol {
@@ -192,6 +215,21 @@ final class MockJiraService: JiraServiceProtocol {
 
     func prefetch(ticketID: String) {}
     func projectName(for projectKey: String) -> String? { nil }
+
+    func addWorklog(
+        ticketID: String, started: Date, timeSpent: TimeInterval, comment: String
+    ) async throws {}
+
+    func worklogs(for ticketID: String) async throws -> [JiraWorklog] { [] }
+    func setWatching(_ watching: Bool, ticketID: String) async throws {}
+    func setVoted(_ voted: Bool, ticketID: String) async throws {}
+
+    func createIssue(
+        projectKey: String, issueType: String, summary: String,
+        description: String, priority: String?
+    ) async throws -> JiraCreatedIssue {
+        JiraCreatedIssue(key: "\(projectKey)-1", serverURL: "https://jira.example.com")
+    }
 }
 
 @MainActor @Observable
@@ -7,19 +7,37 @@ final class BitbucketLink {
     var repositorySlug: String
     var prNumber: Int
     var serverURL: String
+    // Project key on Server/Data Center, workspace on Cloud
+    var owner: String = ""
 
     var todo: Todo?
 
+    var isCloud: Bool {
+        URL(string: serverURL)?.host?.hasSuffix("bitbucket.org") ?? false
+    }
+
+    var browseURL: URL? {
+        guard !owner.isEmpty else { return nil }
+        let base = serverURL
+            .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
+        let path = isCloud
+            ? "\(owner)/\(repositorySlug)/pull-requests/\(prNumber)"
+            : "projects/\(owner)/repos/\(repositorySlug)/pull-requests/\(prNumber)"
+        return URL(string: "\(base)/\(path)")
+    }
+
     init(
         repositorySlug: String,
         prNumber: Int,
         serverURL: String,
+        owner: String = "",
         todo: Todo? = nil
     ) {
         self.id = UUID()
         self.repositorySlug = repositorySlug
         self.prNumber = prNumber
         self.serverURL = serverURL
+        self.owner = owner
         self.todo = todo
     }
 }
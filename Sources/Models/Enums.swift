@@ -23,6 +23,238 @@ enum Priority: String, Codable, CaseIterable, Identifiable {
         case .low: 2
         }
     }
+
+    /// Name of the matching priority in Jira's default priority scheme.
+    var jiraName: String {
+        switch self {
+        case .high: "High"
+        case .medium: "Medium"
+        case .low: "Low"
+        }
+    }
+}
+
+enum TodoKind: String, Codable, CaseIterable, Identifiable {
+    case task
+    case reference
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .task: "Todo"
+        case .reference: "Reference"
+        }
+    }
+}
+
+enum ReminderLeadTime: Int, CaseIterable, Identifiable {
+    case oneDay = 1
+    case twoDays = 2
+    case oneWeek = 7
+
+    var id: Int { rawValue }
+
+    var label: String {
+        switch self {
+        case .oneDay: "1 day before"
+        case .twoDays: "2 days before"
+        case .oneWeek: "1 week before"
+        }
+    }
+}
+
+/// Optional details a todo row can show, chosen and ordered in Settings.
+enum TodoRowField: String, CaseIterable, Identifiable {
+    case priority
+    case project
+    case tags
+    case dueDate
+    case jira
+    case age
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .priority: "Priority"
+        case .project: "Project"
+        case .tags: "Tags"
+        case .dueDate: "Due date"
+        case .jira: "Jira ticket"
+        case .age: "Age"
+        }
+    }
+}
+
+enum TodoSort: String, CaseIterable, Identifiable {
+    case manual
+    case dueDate
+    case priority
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .manual: "Manual"
+        case .dueDate: "Due Date"
+        case .priority: "Priority"
+        }
+    }
+
+    /// Stable sort; todos without a due date go last when sorting by due date.
+    func sorted(_ todos: [Todo]) -> [Todo] {
+        let indexed = Array(todos.enumerated())
+        let ordered: [(offset: Int, element: Todo)]
+        switch self {
+        case .manual:
+            return todos
+        case .dueDate:
+            ordered = indexed.sorted { lhs, rhs in
+                switch (lhs.element.dueDate, rhs.element.dueDate) {
+                case let (l?, r?) where l != r: l < r
+                case (.some, nil): true
+                case (nil, .some): false
+                default: lhs.offset < rhs.offset
+                }
+            }
+        case .priority:
+            ordered = indexed.sorted { lhs, rhs in
+                let l = lhs.element.priority.sortOrder
+                let r = rhs.element.priority.sortOrder
+                return l != r ? l < r : lhs.offset < rhs.offset
+            }
+        }
+        return ordered.map(\.element)
+    }
+}
+
+enum TodoGrouping: String, CaseIterable, Identifiable {
+    case none
+    case project
+    case priority
+    case dueDate
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .none: "None"
+        case .project: "Project"
+        case .priority: "Priority"
+        case .dueDate: "Due Date"
+        }
+    }
+
+    /// Splits `todos` into titled sections, keeping each section's todos in
+    /// their original order. Empty sections are left out.
+    func groups(_ todos: [Todo], now: Date = Date()) -> [TodoGroup] {
+        switch self {
+        case .none:
+            return [TodoGroup(id: "all", title: "", todos: todos)]
+        case .project:
+            let byProject = Dictionary(grouping: todos) { $0.project?.persistentModelID }
+            let projects = todos.compactMap(\.project)
+                .reduce(into: [Project]()) { result, project in
+                    if !result.contains(where: { $0.id == project.id }) {
+                        result.append(project)
+                    }
+                }
+                .sorted { $0.sortOrder < $1.sortOrder }
+            var groups = projects.map { project in
+                TodoGroup(
+                    id: "project.\(project.id.uuidString)",
+                    title: project.name,
+                    todos: byProject[project.persistentModelID] ?? []
+                )
+            }
+            if let loose = byProject[nil] {
+                groups.append(TodoGroup(id: "project.none", title: "No Project", todos: loose))
+            }
+            return groups
+        case .priority:
+            return Priority.allCases
+                .sorted { $0.sortOrder < $1.sortOrder }
+                .map { priority in
+                    TodoGroup(
+                        id: "priority.\(priority.rawValue)",
+                        title: priority.label,
+                        todos: todos.filter { $0.priority == priority }
+                    )
+                }
+                .filter { !$0.todos.isEmpty }
+        case .dueDate:
+            let calendar = Calendar.current
+            let today = calendar.startOfDay(for: now)
+            let tomorrow = calendar.date(byAdding: .day, value: 1, to: today)!
+            let nextWeek = calendar.date(byAdding: .day, value: 7, to: today)!
+            func bucket(_ todo: Todo) -> Int {
+                guard let due = todo.dueDate else { return 4 }
+                if due < today { return 0 }
+                if due < tomorrow { return 1 }
+                if due < nextWeek { return 2 }
+                return 3
+            }
+            let titles = ["Overdue", "Today", "Next 7 Days", "Later", "No Due Date"]
+            return titles.indices
+                .map { index in
+                    TodoGroup(
+                        id: "due.\(index)",
+                        title: titles[index],
+                        todos: todos.filter { bucket($0) == index }
+                    )
+                }
+                .filter { !$0.todos.isEmpty }
+        }
+    }
+}
+
+/// A section of the todo list. The ID stays stable while titles may repeat,
+/// e.g. a project named "No Project" or two projects sharing a name in Trash.
+struct TodoGroup: Identifiable {
+    let id: String
+    let title: String
+    let todos: [Todo]
+}
+
+enum SnoozeOption: String, CaseIterable, Identifiable {
+    case oneHour
+    case tonight
+    case tomorrow
+    case nextWeek
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .oneHour: "For 1 Hour"
+        case .tonight: "Until Tonight"
+        case .tomorrow: "Until Tomorrow"
+        case .nextWeek: "Until Next Week"
+        }
+    }
+
+    /// Tonight is 18:00, tomorrow is 09:00 and next week is Monday 09:00.
+    func date(from now: Date = Date()) -> Date {
+        let calendar = Calendar.current
+        switch self {
+        case .oneHour:
+            return now.addingTimeInterval(3600)
+        case .tonight:
+            let evening = calendar.date(bySettingHour: 18, minute: 0, second: 0, of: now)!
+            return evening > now ? evening : now.addingTimeInterval(3600)
+        case .tomorrow:
+            let tomorrow = calendar.date(byAdding: .day, value: 1, to: now)!
+            return calendar.date(bySettingHour: 9, minute: 0, second: 0, of: tomorrow)!
+        case .nextWeek:
+            let monday = calendar.nextDate(
+                after: now,
+                matching: DateComponents(hour: 9, minute: 0, weekday: 2),
+                matchingPolicy: .nextTime
+            )
+            return monday ?? now.addingTimeInterval(7 * 86_400)
+        }
+    }
 }
 
 enum BookingStatus: String, Codable, CaseIterable, Identifiable {
@@ -122,11 +354,15 @@ enum IntegrationType: String, Codable, CaseIterable, Identifiable {
 enum ValidationError: Error, LocalizedError {
     case emptyName
     case duplicateName(String)
+    case invalidColor(String)
+    case dependencyCycle
 
     var errorDescription: String? {
         switch self {
         case .emptyName: "Name cannot be empty"
         case .duplicateName(let name): "'\(name)' already exists"
+        case .invalidColor(let value): "'\(value)' is not a hex color like #007AFF"
+        case .dependencyCycle: "That would make the todo depend on itself"
         }
     }
 }
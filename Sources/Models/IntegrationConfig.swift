@@ -11,12 +11,16 @@ final class IntegrationConfig {
     var isEnabled: Bool
     var lastSyncedAt: Date?
 
+    // Atlassian Cloud: `username` holds the account email used for basic auth
+    var isCloud: Bool = false
+
     init(
         type: IntegrationType,
         serverURL: String,
         username: String,
         syncInterval: TimeInterval = 900,
-        isEnabled: Bool = true
+        isEnabled: Bool = true,
+        isCloud: Bool = false
     ) {
         self.id = UUID()
         self.type = type
@@ -25,5 +29,6 @@ final class IntegrationConfig {
         self.syncInterval = syncInterval
         self.isEnabled = isEnabled
         self.lastSyncedAt = nil
+        self.isCloud = isCloud
     }
 }
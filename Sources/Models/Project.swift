@@ -10,8 +10,16 @@ final class Project {
     var sortOrder: Int
     var createdAt: Date
 
+    // SF Symbol name shown next to the project name
+    var icon: String = "folder"
+
+    // Trashed projects are restorable until purged after `AppConfig.todoPurgeDays`
+    var deletedAt: Date?
+
     var todos: [Todo]
 
+    var isTrashed: Bool { deletedAt != nil }
+
     init(
         name: String,
         color: String = "#007AFF",
@@ -24,6 +32,8 @@ final class Project {
         self.descriptionText = descriptionText
         self.sortOrder = sortOrder
         self.createdAt = Date()
+        self.icon = "folder"
+        self.deletedAt = nil
         self.todos = []
     }
 }
@@ -8,6 +8,7 @@ final class Todo {
     var descriptionText: String
     var priority: Priority
     var dueDate: Date?
+    var startDate: Date?
     var isCompleted: Bool
     var completedAt: Date?
     var createdAt: Date
@@ -15,6 +16,18 @@ final class Todo {
     var deletedAt: Date?
     var sortOrder: Int
 
+    // Reference items (links, docs to keep) have no due/overdue semantics
+    var kind: TodoKind = TodoKind.task
+
+    // Hidden from default lists until this time, then resurfaced with a notification
+    var snoozedUntil: Date?
+
+    // Per-todo reminder lead days; nil follows the global setting, empty disables
+    var reminderLeadDays: [Int]?
+
+    // Archived todos are hidden from every list except the Archive
+    var archivedAt: Date?
+
     @Relationship(inverse: \Project.todos)
     var project: Project?
 
@@ -30,8 +43,27 @@ final class Todo {
     @Relationship(deleteRule: .cascade, inverse: \BitbucketLink.todo)
     var bitbucketLink: BitbucketLink?
 
+    // Todos that have to be finished before this one
+    @Relationship(inverse: \Todo.blocking)
+    var blockers: [Todo] = []
+
+    var blocking: [Todo] = []
+
     var isActive: Bool { !isCompleted && deletedAt == nil }
     var isTrashed: Bool { deletedAt != nil }
+    var isArchived: Bool { archivedAt != nil }
+    var isReference: Bool { kind == .reference }
+    var isSnoozed: Bool { snoozedUntil.map { $0 > Date() } ?? false }
+    var openBlockers: [Todo] { blockers.filter(\.isActive) }
+    var openBlocking: [Todo] { blocking.filter(\.isActive) }
+    var isBlocked: Bool { !openBlockers.isEmpty }
+
+    var browseURL: URL? {
+        guard let jiraLink else { return bitbucketLink?.browseURL }
+        let base = jiraLink.serverURL
+            .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
+        return URL(string: "\(base)/browse/\(jiraLink.ticketID)")
+    }
 
     init(
         title: String,
@@ -58,5 +90,11 @@ final class Todo {
         self.timeEntries = []
         self.jiraLink = nil
         self.bitbucketLink = nil
+        self.blockers = []
+        self.blocking = []
+        self.snoozedUntil = nil
+        self.reminderLeadDays = nil
+        self.archivedAt = nil
+        self.startDate = nil
     }
 }
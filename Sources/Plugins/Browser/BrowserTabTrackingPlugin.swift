@@ -51,6 +51,7 @@ class BrowserTabTrackingPlugin: TimeTrackingPlugin {
     private var minimumDuration: TimeInterval { AppConfig.browserMinDuration }
 
     private var bbToken: String?
+    private var bbUsername = ""
     private var bbCredentialsLoaded = false
     private var prCache: [String: BitbucketPRDetail] = [:]
 
@@ -126,8 +127,9 @@ class BrowserTabTrackingPlugin: TimeTrackingPlugin {
     }
 
     func stop() async throws {
-        finalizeCurrentEntry()
         stopPolling()
+        // Wait for the write so quitting doesn't drop the last entry
+        await finalizeCurrentEntry()?.value
 
         if let obs = activationObserver {
             NSWorkspace.shared.notificationCenter.removeObserver(obs)
@@ -180,7 +182,7 @@ class BrowserTabTrackingPlugin: TimeTrackingPlugin {
         guard isBrowserActive else { return }
         guard let tabInfo = await readCurrentTab() else {
             logService?.log(
-                "\(prefix) Could not read tab info", level: .error
+                "\(prefix) Could not read tab info", level: .warning
             )
             return
         }
@@ -299,22 +301,27 @@ class BrowserTabTrackingPlugin: TimeTrackingPlugin {
         guard let token = bbToken else { return nil }
 
         let detail = await BrowserTabService.fetchBitbucketPR(
-            ref: ref, token: token
+            ref: ref, token: token, username: bbUsername
         )
         if let detail {
             prCache[cacheKey] = detail
+        } else {
+            // Credentials may have been edited or rejected; reload on next poll
+            bbCredentialsLoaded = false
         }
         return detail
     }
 
     // MARK: - Entry Management
 
-    private func finalizeCurrentEntry() {
-        guard let entryID = currentEntryID else { return }
+    @discardableResult
+    private func finalizeCurrentEntry() -> Task<Void, Never>? {
+        guard let entryID = currentEntryID else { return nil }
 
+        let task: Task<Void, Never>
         if let start = entryStartTime,
            Date().timeIntervalSince(start) < minimumDuration {
-            Task {
+            task = Task {
                 let context = ModelContext(self.modelContainer)
                 if let entry = context.model(for: entryID) as? TimeEntry {
                     context.delete(entry)
@@ -323,15 +330,17 @@ class BrowserTabTrackingPlugin: TimeTrackingPlugin {
             }
         } else {
             let service = TimeEntryService(modelContainer: modelContainer)
-            Task {
+            let endTime = Date()
+            task = Task {
                 try? await service.finalize(
-                    entryID: entryID, endTime: Date()
+                    entryID: entryID, endTime: endTime
                 )
             }
         }
 
         currentEntryID = nil
         entryStartTime = nil
+        return task
     }
 
     // MARK: - Helpers
@@ -349,11 +358,13 @@ class BrowserTabTrackingPlugin: TimeTrackingPlugin {
             predicate: predicate
         )
 
+        bbToken = nil
         if let config = try? context.fetch(descriptor).first,
            config.isEnabled {
             bbToken = try? KeychainService.retrieve(
                 key: "bitbucket_token"
             )
+            bbUsername = config.username
         }
     }
 
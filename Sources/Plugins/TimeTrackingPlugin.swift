@@ -58,7 +58,7 @@ final class PluginManager {
     }
 
     func enable(pluginID: String) async {
-        UserDefaults.standard.set(true, forKey: enabledKey(for: pluginID))
+        AppConfig.defaults.set(true, forKey: enabledKey(for: pluginID))
         guard let plugin = plugin(id: pluginID),
               plugin.isAvailable() else { return }
         do {
@@ -72,7 +72,7 @@ final class PluginManager {
     }
 
     func disable(pluginID: String) async {
-        UserDefaults.standard.set(false, forKey: enabledKey(for: pluginID))
+        AppConfig.defaults.set(false, forKey: enabledKey(for: pluginID))
         guard let plugin = plugin(id: pluginID) else { return }
         do {
             try await plugin.stop()
@@ -86,11 +86,11 @@ final class PluginManager {
 
     func isEnabled(pluginID: String) -> Bool {
         let key = enabledKey(for: pluginID)
-        if UserDefaults.standard.object(forKey: key) == nil {
+        if AppConfig.defaults.object(forKey: key) == nil {
             // Defaults: all plugins enabled
             return true
         }
-        return UserDefaults.standard.bool(forKey: key)
+        return AppConfig.defaults.bool(forKey: key)
     }
 
     func plugin(id: String) -> (any TimeTrackingPlugin)? {
@@ -81,7 +81,7 @@ final class WakaTimePlugin: TimeTrackingPlugin {
         // Load overrides and settings for ticket inference
         let context = ModelContext(modelContainer)
         let overrides = (try? context.fetch(FetchDescriptor<TicketOverride>())) ?? []
-        let excludedData = UserDefaults.standard.data(forKey: "excludedProjectsData")
+        let excludedData = AppConfig.defaults.data(forKey: "excludedProjectsData")
         let excludedProjects: Set<String> = excludedData
             .flatMap { try? JSONDecoder().decode(Set<String>.self, from: $0) } ?? []
 
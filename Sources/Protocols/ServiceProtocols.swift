@@ -15,7 +15,7 @@ protocol TodoServiceProtocol {
 
     func update(
         _ todo: Todo, title: String?, descriptionText: String?,
-        priority: Priority?, dueDate: Date??,
+        priority: Priority?, startDate: Date??, dueDate: Date??,
         project: Project??, tags: [Tag]?
     )
 
@@ -24,18 +24,32 @@ protocol TodoServiceProtocol {
     func toggleComplete(_ todo: Todo)
     func softDelete(_ todo: Todo)
     func restore(_ todo: Todo)
+    func permanentlyDelete(_ todo: Todo)
+    func setKind(_ todo: Todo, kind: TodoKind)
+    func linkJira(_ todo: Todo, ticketID: String, serverURL: String)
+    func addBlocker(_ blocker: Todo, to todo: Todo) throws
+    func removeBlocker(_ blocker: Todo, from todo: Todo)
+    func snooze(_ todo: Todo, until date: Date?)
+    func archive(_ todo: Todo)
+    func unarchive(_ todo: Todo)
+    func archiveCompleted(olderThanDays days: Int) throws -> Int
     func purgeExpired() throws -> Int
+    func purgeArchived(retentionDays: Int) throws -> Int
 
     func list(
         project: Project?,
         tag: Tag?,
         priority: Priority?,
         isCompleted: Bool?,
+        kind: TodoKind?,
+        isSnoozed: Bool?,
         searchText: String,
-        includeTrashed: Bool
+        includeTrashed: Bool,
+        includeArchived: Bool
     ) throws -> [Todo]
 
     func listTrashed() throws -> [Todo]
+    func listArchived() throws -> [Todo]
     func reorder(_ todo: Todo, newSortOrder: Int)
 }
 
@@ -60,12 +74,12 @@ extension TodoServiceProtocol {
 
     func update(
         _ todo: Todo, title: String? = nil, descriptionText: String? = nil,
-        priority: Priority? = nil, dueDate: Date?? = nil,
+        priority: Priority? = nil, startDate: Date?? = nil, dueDate: Date?? = nil,
         project: Project?? = nil, tags: [Tag]? = nil
     ) {
         update(
             todo, title: title, descriptionText: descriptionText,
-            priority: priority, dueDate: dueDate,
+            priority: priority, startDate: startDate, dueDate: dueDate,
             project: project, tags: tags
         )
     }
@@ -75,25 +89,38 @@ extension TodoServiceProtocol {
         tag: Tag? = nil,
         priority: Priority? = nil,
         isCompleted: Bool? = nil,
+        kind: TodoKind? = nil,
+        isSnoozed: Bool? = nil,
         searchText: String = "",
-        includeTrashed: Bool = false
+        includeTrashed: Bool = false,
+        includeArchived: Bool = false
     ) throws -> [Todo] {
         try list(
             project: project,
             tag: tag,
             priority: priority,
             isCompleted: isCompleted,
+            kind: kind,
+            isSnoozed: isSnoozed,
             searchText: searchText,
-            includeTrashed: includeTrashed
+            includeTrashed: includeTrashed,
+            includeArchived: includeArchived
         )
     }
 }
 
 protocol ProjectServiceProtocol {
     func create(name: String, color: String, descriptionText: String) throws -> Project
-    func update(_ project: Project, name: String?, color: String?, descriptionText: String?) throws
+    func update(
+        _ project: Project, name: String?, color: String?, icon: String?,
+        descriptionText: String?
+    ) throws
     func delete(_ project: Project)
+    func restore(_ project: Project) throws
+    func permanentlyDelete(_ project: Project)
+    func purgeExpired() throws -> Int
     func list() throws -> [Project]
+    func listTrashed() throws -> [Project]
 }
 
 extension ProjectServiceProtocol {
@@ -105,9 +132,12 @@ extension ProjectServiceProtocol {
 
     func update(
         _ project: Project, name: String? = nil, color: String? = nil,
-        descriptionText: String? = nil
+        icon: String? = nil, descriptionText: String? = nil
     ) throws {
-        try update(project, name: name, color: color, descriptionText: descriptionText)
+        try update(
+            project, name: name, color: color, icon: icon,
+            descriptionText: descriptionText
+        )
     }
 }
 
@@ -244,9 +274,11 @@ extension TimeEntryServiceProtocol {
 
 protocol ExportServiceProtocol: Actor {
     func generateExport(for date: Date) throws -> ExportResult
+    func generateWorklogs(for date: Date, roundingMinutes: Int) throws -> [WorklogDraft]
     func checkDuplicates(entryIDs: [UUID]) throws -> [UUID]
     func confirmExport(result: ExportResult) throws -> PersistentIdentifier
     func markBooked(exportID: PersistentIdentifier) throws
+    func markBooked(entryIDs: [UUID]) throws
 }
 
 protocol LearnedPatternServiceProtocol: Actor {
@@ -266,6 +298,16 @@ protocol JiraServiceProtocol {
     func ticketInfo(for ticketID: String) async -> JiraTicketInfo?
     func prefetch(ticketID: String)
     func projectName(for projectKey: String) -> String?
+    func addWorklog(
+        ticketID: String, started: Date, timeSpent: TimeInterval, comment: String
+    ) async throws
+    func worklogs(for ticketID: String) async throws -> [JiraWorklog]
+    func setWatching(_ watching: Bool, ticketID: String) async throws
+    func setVoted(_ voted: Bool, ticketID: String) async throws
+    func createIssue(
+        projectKey: String, issueType: String, summary: String,
+        description: String, priority: String?
+    ) async throws -> JiraCreatedIssue
 }
 
 @MainActor
@@ -0,0 +1,153 @@
+import Foundation
+import SQLite3
+
+enum BackupError: Error, LocalizedError {
+    case storeNotFound
+    case sqlite(String)
+
+    var errorDescription: String? {
+        switch self {
+        case .storeNotFound: "The data store could not be found"
+        case .sqlite(let message): "Backup failed: \(message)"
+        }
+    }
+}
+
+/// Snapshots the SwiftData SQLite store with `VACUUM INTO`, which produces a
+/// consistent copy while the app keeps the store open. Restores are staged and
+/// applied on the next launch, before the model container opens the store.
+struct BackupService {
+    let storeURL: URL
+
+    static let backupsDirectory: URL = {
+        let appSupport = FileManager.default.urls(
+            for: .applicationSupportDirectory, in: .userDomainMask
+        ).first!
+        let dir = appSupport
+            .appendingPathComponent("TaskManagement", isDirectory: true)
+            .appendingPathComponent("Backups", isDirectory: true)
+        try? FileManager.default.createDirectory(
+            at: dir, withIntermediateDirectories: true
+        )
+        return dir
+    }()
+
+    private static let pendingRestoreURL = backupsDirectory
+        .appendingPathComponent("pending-restore.store")
+
+    private static let fileNameFormatter: DateFormatter = {
+        let formatter = DateFormatter()
+        formatter.locale = Locale(identifier: "en_US_POSIX")
+        formatter.dateFormat = "yyyy-MM-dd-HHmmss"
+        return formatter
+    }()
+
+    // MARK: - Backup
+
+    @discardableResult
+    func backup(now: Date = Date()) throws -> URL {
+        guard FileManager.default.fileExists(atPath: storeURL.path) else {
+            throw BackupError.storeNotFound
+        }
+        let name = "TaskManagement-\(Self.fileNameFormatter.string(from: now)).store"
+        let destination = Self.backupsDirectory.appendingPathComponent(name)
+
+        var db: OpaquePointer?
+        guard sqlite3_open_v2(storeURL.path, &db, SQLITE_OPEN_READONLY, nil) == SQLITE_OK else {
+            defer { sqlite3_close(db) }
+            throw BackupError.sqlite(String(cString: sqlite3_errmsg(db)))
+        }
+        defer { sqlite3_close(db) }
+
+        let escaped = destination.path.replacingOccurrences(of: "'", with: "''")
+        guard sqlite3_exec(db, "VACUUM INTO '\(escaped)'", nil, nil, nil) == SQLITE_OK else {
+            throw BackupError.sqlite(String(cString: sqlite3_errmsg(db)))
+        }
+        return destination
+    }
+
+    /// Backups newest first.
+    static func listBackups(in directory: URL = backupsDirectory) -> [URL] {
+        let files = (try? FileManager.default.contentsOfDirectory(
+            at: directory,
+            includingPropertiesForKeys: [.creationDateKey]
+        )) ?? []
+        return files
+            .filter { $0.lastPathComponent.hasPrefix("TaskManagement-") && $0.pathExtension == "store" }
+            .sorted { $0.lastPathComponent > $1.lastPathComponent }
+    }
+
+    /// Deletes all but the newest `keep` backups. Returns the number removed.
+    @discardableResult
+    static func rotate(keep: Int, in directory: URL = backupsDirectory) -> Int {
+        let stale = listBackups(in: directory).dropFirst(max(keep, 1))
+        for url in stale {
+            try? FileManager.default.removeItem(at: url)
+        }
+        return stale.count
+    }
+
+    /// Creates a backup when the newest one is older than a day.
+    @discardableResult
+    func backupIfDue(keep: Int, now: Date = Date()) throws -> URL? {
+        if let latest = Self.listBackups().first,
+           let created = try? latest.resourceValues(forKeys: [.creationDateKey]).creationDate,
+           now.timeIntervalSince(created) < 86_400 {
+            return nil
+        }
+        let url = try backup(now: now)
+        Self.rotate(keep: keep)
+        return url
+    }
+
+    // MARK: - Restore
+
+    /// Stages a backup to replace the store on next launch.
+    static func scheduleRestore(from backup: URL) throws {
+        if FileManager.default.fileExists(atPath: pendingRestoreURL.path) {
+            try FileManager.default.removeItem(at: pendingRestoreURL)
+        }
+        try FileManager.default.copyItem(at: backup, to: pendingRestoreURL)
+    }
+
+    /// Replaces the store with a staged backup. Must run before the
+    /// ModelContainer is created. Returns true when a restore was applied.
+    ///
+    /// The current store is backed up first and swapped out atomically, and
+    /// the staged file is only removed once the swap has succeeded, so a
+    /// failure leaves the existing data in place and the restore still pending.
+    @discardableResult
+    func applyPendingRestore() throws -> Bool {
+        let fileManager = FileManager.default
+        guard fileManager.fileExists(atPath: Self.pendingRestoreURL.path) else { return false }
+
+        let storeExists = fileManager.fileExists(atPath: storeURL.path)
+        if storeExists {
+            try backup()
+        }
+
+        let staged = storeURL.deletingLastPathComponent()
+            .appendingPathComponent("restore-\(UUID().uuidString).store")
+        try fileManager.copyItem(at: Self.pendingRestoreURL, to: staged)
+        do {
+            if storeExists {
+                _ = try fileManager.replaceItemAt(storeURL, withItemAt: staged)
+            } else {
+                try fileManager.moveItem(at: staged, to: storeURL)
+            }
+        } catch {
+            try? fileManager.removeItem(at: staged)
+            throw error
+        }
+
+        // The old journal belongs to the replaced database
+        for suffix in ["-wal", "-shm"] {
+            let url = URL(fileURLWithPath: storeURL.path + suffix)
+            if fileManager.fileExists(atPath: url.path) {
+                try? fileManager.removeItem(at: url)
+            }
+        }
+        try? fileManager.removeItem(at: Self.pendingRestoreURL)
+        return true
+    }
+}
@@ -14,13 +14,14 @@ struct BitbucketPRInfo: Identifiable {
     let sourceBranch: String
     let ticketID: String?
     let browseURL: URL?
-    let fetchedAt: Date
+    var fetchedAt: Date
 }
 
 @MainActor @Observable
 final class BitbucketService: BitbucketServiceProtocol {
     private var cache: [String: BitbucketPRInfo] = [:]
     private var inFlight: [String: Task<BitbucketPRInfo?, Never>] = [:]
+    private var validators: [String: HTTPValidators] = [:]
     private var cacheTTL: TimeInterval { AppConfig.bitbucketCacheTTL }
 
     private let modelContainer: ModelContainer
@@ -34,7 +35,7 @@ final class BitbucketService: BitbucketServiceProtocol {
     func prInfo(for prURL: String) async -> BitbucketPRInfo? {
         if let cached = cache[prURL],
            Date().timeIntervalSince(cached.fetchedAt) < cacheTTL {
-            logService?.log("BB cache hit for \(prURL)")
+            logService?.log("BB cache hit for \(prURL)", level: .debug)
             return cached
         }
 
@@ -80,6 +81,7 @@ final class BitbucketService: BitbucketServiceProtocol {
     private struct BitbucketCredentials {
         let serverURL: String
         let token: String
+        let username: String
     }
 
     @MainActor
@@ -106,13 +108,14 @@ final class BitbucketService: BitbucketServiceProtocol {
         ),
               !config.serverURL.isEmpty,
               let token, !token.isEmpty else {
-            logService?.log("BB credential check failed", level: .error)
+            logService?.log("BB credential check failed", level: .warning)
             return nil
         }
 
         return BitbucketCredentials(
             serverURL: config.serverURL,
-            token: token
+            token: token,
+            username: config.username
         )
     }
 
@@ -126,18 +129,16 @@ final class BitbucketService: BitbucketServiceProtocol {
 
         guard let credentials = loadCredentials() else {
             logService?.log(
-                "No BB credentials for \(prURL)", level: .error
+                "No BB credentials for \(prURL)", level: .warning
             )
             return nil
         }
 
         let base = credentials.serverURL
             .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
-        let apiURL = "\(base)/rest/api/1.0/projects/\(ref.projectKey)"
-            + "/repos/\(ref.repoSlug)"
-            + "/pull-requests/\(ref.prNumber)"
+        let apiURL = ref.apiURL(baseURL: base)
 
-        logService?.log("Fetching \(apiURL)")
+        logService?.log("Fetching \(apiURL)", level: .debug)
 
         guard let url = URL(string: apiURL) else {
             logService?.log("Invalid API URL: \(apiURL)", level: .error)
@@ -148,9 +149,13 @@ final class BitbucketService: BitbucketServiceProtocol {
         request.httpMethod = "GET"
         request.setValue("application/json", forHTTPHeaderField: "Accept")
         request.timeoutInterval = 10
-        request.setValue(
-            "Bearer \(credentials.token)",
-            forHTTPHeaderField: "Authorization"
+        request.setAtlassianAuthorization(
+            token: credentials.token,
+            username: credentials.username,
+            isCloud: ref.isCloud
+        )
+        request.setConditionalHeaders(
+            cache[prURL] == nil ? nil : validators[apiURL]
         )
 
         do {
@@ -162,7 +167,15 @@ final class BitbucketService: BitbucketServiceProtocol {
                 return nil
             }
             logService?.log("HTTP \(http.statusCode) for \(prURL)")
+            if http.statusCode == 304, var cached = cache[prURL] {
+                logService?.log("BB not modified: \(prURL)", level: .debug)
+                cached.fetchedAt = Date()
+                return cached
+            }
             guard http.statusCode == 200 else {
+                if http.statusCode == 401 || http.statusCode == 403 {
+                    KeychainService.invalidateCache()
+                }
                 if let body = String(data: data, encoding: .utf8) {
                     logService?.log(
                         "Response body: \(String(body.prefix(300)))",
@@ -180,6 +193,7 @@ final class BitbucketService: BitbucketServiceProtocol {
                 return nil
             }
 
+            validators[apiURL] = HTTPValidators(response: http)
             return parseResponse(
                 json: json, prURL: prURL, ref: ref
             )
@@ -200,17 +214,16 @@ final class BitbucketService: BitbucketServiceProtocol {
         let title = json["title"] as? String ?? ""
         let state = json["state"] as? String ?? "UNKNOWN"
 
-        let authorObj = json["author"] as? [String: Any]
-        let authorUser = authorObj?["user"] as? [String: Any]
-        let author = authorUser?["displayName"] as? String ?? "Unknown"
-
-        let fromRef = json["fromRef"] as? [String: Any]
-        let sourceBranch = fromRef?["displayId"] as? String ?? ""
+        let author = BrowserTabService.bitbucketAuthor(json: json) ?? "Unknown"
+        let sourceBranch = BrowserTabService.bitbucketSourceBranch(json: json)
 
         let reviewersList = json["reviewers"] as? [[String: Any]] ?? []
         let reviewers = reviewersList.compactMap { reviewer -> String? in
-            let user = reviewer["user"] as? [String: Any]
-            return user?["displayName"] as? String
+            if let user = reviewer["user"] as? [String: Any] {
+                return user["displayName"] as? String
+            }
+            // Cloud lists reviewers as bare account objects
+            return reviewer["display_name"] as? String
         }
 
         let titleTicket = BrowserTabService.extractTicketID(from: title)
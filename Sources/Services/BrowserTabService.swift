@@ -9,9 +9,22 @@ struct BrowserTabInfo {
 
 struct BitbucketPRRef {
     let serverURL: String
+    /// Project key on Server/Data Center, workspace on Cloud.
     let projectKey: String
     let repoSlug: String
     let prNumber: Int
+    let isCloud: Bool
+
+    /// `baseURL` overrides the host parsed from the PR URL, e.g. to keep a
+    /// Server context path from the configured integration.
+    func apiURL(baseURL: String? = nil) -> String {
+        if isCloud {
+            return "\(BrowserTabService.bitbucketCloudAPIBase)/repositories/\(projectKey)"
+                + "/\(repoSlug)/pullrequests/\(prNumber)"
+        }
+        return "\(baseURL ?? serverURL)/rest/api/1.0/projects/\(projectKey)"
+            + "/repos/\(repoSlug)/pull-requests/\(prNumber)"
+    }
 }
 
 struct BitbucketPRDetail {
@@ -23,6 +36,7 @@ struct BitbucketPRDetail {
 
 enum BrowserTabService {
     private static let ticketPattern = try! Regex("[A-Z][A-Z0-9]+-\\d+")
+    static let bitbucketCloudAPIBase = "https://api.bitbucket.org/2.0"
 
     // MARK: - Chrome (AppleScript)
 
@@ -207,54 +221,64 @@ enum BrowserTabService {
 
     // MARK: - Bitbucket URL Parsing
 
-    /// Parses a Bitbucket Server PR URL:
+    /// Parses a Bitbucket Server or Cloud PR URL:
     /// `https://bitbucket.example.com/projects/PROJ/repos/my-repo/pull-requests/42`
+    /// `https://bitbucket.org/workspace/my-repo/pull-requests/42`
     static func parseBitbucketPRURL(_ url: String) -> BitbucketPRRef? {
-        guard let regex = try? Regex(
-            "(https?://[^/]+)/projects/([^/]+)/repos/([^/]+)/pull-requests/(\\d+)"
-        ),
-        let match = url.firstMatch(of: regex),
-        match.output.count > 4 else { return nil }
-
-        guard let prNum = Int(String(url[match.output[4].range!])) else { return nil }
-        return BitbucketPRRef(
-            serverURL: String(url[match.output[1].range!]),
-            projectKey: String(url[match.output[2].range!]),
-            repoSlug: String(url[match.output[3].range!]),
-            prNumber: prNum
-        )
+        let patterns = [
+            (false, "(https?://[^/]+)/projects/([^/]+)/repos/([^/]+)/pull-requests/(\\d+)"),
+            (true, "(https?://bitbucket\\.org)/([^/]+)/([^/]+)/pull-requests/(\\d+)"),
+        ]
+        for (isCloud, pattern) in patterns {
+            guard let regex = try? Regex(pattern),
+                  let match = url.firstMatch(of: regex),
+                  match.output.count > 4,
+                  let prNum = Int(String(url[match.output[4].range!])) else { continue }
+            return BitbucketPRRef(
+                serverURL: String(url[match.output[1].range!]),
+                projectKey: String(url[match.output[2].range!]),
+                repoSlug: String(url[match.output[3].range!]),
+                prNumber: prNum,
+                isCloud: isCloud
+            )
+        }
+        return nil
     }
 
     // MARK: - Bitbucket REST API
 
-    /// Fetches PR details from Bitbucket Server REST API.
+    /// Fetches PR details from the Bitbucket Server (1.0) or Cloud (2.0) REST API.
     /// Uses credentials from IntegrationConfig + Keychain.
     static func fetchBitbucketPR(
         ref: BitbucketPRRef,
-        token: String
+        token: String,
+        username: String = ""
     ) async -> BitbucketPRDetail? {
-        let urlString = "\(ref.serverURL)/rest/api/1.0/projects/\(ref.projectKey)/repos/\(ref.repoSlug)/pull-requests/\(ref.prNumber)"
-        guard let url = URL(string: urlString) else { return nil }
+        guard let url = URL(string: ref.apiURL()) else { return nil }
 
         var request = URLRequest(url: url)
-        request.setValue("Bearer \(token)", forHTTPHeaderField: "Authorization")
+        request.setAtlassianAuthorization(
+            token: token, username: username, isCloud: ref.isCloud
+        )
         request.timeoutInterval = 10
 
         do {
             let (data, response) = try await URLSession.shared.data(for: request)
-            guard let httpResponse = response as? HTTPURLResponse,
-                  (200..<300).contains(httpResponse.statusCode) else { return nil }
+            guard let httpResponse = response as? HTTPURLResponse else { return nil }
+            guard (200..<300).contains(httpResponse.statusCode) else {
+                if httpResponse.statusCode == 401 || httpResponse.statusCode == 403 {
+                    KeychainService.invalidateCache()
+                }
+                return nil
+            }
 
             guard let json = try JSONSerialization.jsonObject(with: data) as? [String: Any] else {
                 return nil
             }
 
             let title = json["title"] as? String ?? ""
-            let fromRef = json["fromRef"] as? [String: Any]
-            let sourceBranch = fromRef?["displayId"] as? String ?? ""
-            let author = json["author"] as? [String: Any]
-            let authorUser = author?["user"] as? [String: Any]
-            let creator = authorUser?["displayName"] as? String
+            let sourceBranch = bitbucketSourceBranch(json: json)
+            let creator = bitbucketAuthor(json: json)
 
             // Extract ticket from branch name first, then title
             let ticketID = extractTicketID(from: sourceBranch)
@@ -271,4 +295,25 @@ enum BrowserTabService {
             return nil
         }
     }
+
+    // MARK: - Bitbucket JSON Helpers
+
+    /// Server nests the branch under `fromRef.displayId`; Cloud under `source.branch.name`.
+    static func bitbucketSourceBranch(json: [String: Any]) -> String {
+        if let fromRef = json["fromRef"] as? [String: Any] {
+            return fromRef["displayId"] as? String ?? ""
+        }
+        let source = json["source"] as? [String: Any]
+        let branch = source?["branch"] as? [String: Any]
+        return branch?["name"] as? String ?? ""
+    }
+
+    /// Server nests the author under `author.user.displayName`; Cloud under `author.display_name`.
+    static func bitbucketAuthor(json: [String: Any]) -> String? {
+        let author = json["author"] as? [String: Any]
+        if let user = author?["user"] as? [String: Any] {
+            return user["displayName"] as? String
+        }
+        return author?["display_name"] as? String
+    }
 }
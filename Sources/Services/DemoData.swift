@@ -0,0 +1,105 @@
+import Foundation
+import SwiftData
+
+/// Sample projects, todos and time entries for `--demo` launches, so the app
+/// can be shown or tried out without touching real data.
+@MainActor
+enum DemoData {
+    static func seed(into context: ModelContext) throws {
+        let calendar = Calendar.current
+        let now = Date()
+        func days(_ offset: Int) -> Date {
+            calendar.date(byAdding: .day, value: offset, to: now) ?? now
+        }
+
+        let website = Project(name: "Website Redesign", color: "#007AFF", sortOrder: 0)
+        let backend = Project(name: "Billing Backend", color: "#34C759", sortOrder: 1)
+        let personal = Project(name: "Personal", color: "#FF9500", sortOrder: 2)
+        for project in [website, backend, personal] {
+            context.insert(project)
+        }
+
+        let urgent = Tag(name: "urgent", color: "#FF3B30")
+        let review = Tag(name: "review", color: "#AF52DE")
+        context.insert(urgent)
+        context.insert(review)
+
+        let mockups = Todo(
+            title: "Finalize landing page mockups",
+            descriptionText: "Hero section, pricing table and footer.",
+            priority: .high,
+            dueDate: days(1),
+            project: website,
+            tags: [urgent],
+            sortOrder: 0
+        )
+        let copy = Todo(
+            title: "Write copy for the pricing page",
+            dueDate: days(4),
+            project: website,
+            sortOrder: 1
+        )
+        let invoices = Todo(
+            title: "Fix rounding in invoice totals",
+            priority: .high,
+            dueDate: days(-1),
+            project: backend,
+            tags: [urgent],
+            sortOrder: 2
+        )
+        let migration = Todo(
+            title: "Review currency migration PR",
+            project: backend,
+            tags: [review],
+            sortOrder: 3
+        )
+        let dentist = Todo(
+            title: "Book dentist appointment",
+            priority: .low,
+            dueDate: days(7),
+            project: personal,
+            sortOrder: 4
+        )
+        let styleGuide = Todo(
+            title: "Brand style guide",
+            descriptionText: "Colors, typography and logo usage.",
+            project: website,
+            sortOrder: 5
+        )
+        styleGuide.kind = .reference
+        let kickoff = Todo(
+            title: "Kick-off meeting notes",
+            project: website,
+            sortOrder: 6
+        )
+        kickoff.isCompleted = true
+        kickoff.completedAt = days(-3)
+
+        let todos = [mockups, copy, invoices, migration, dentist, styleGuide, kickoff]
+        for todo in todos {
+            context.insert(todo)
+        }
+        copy.blockers = [mockups]
+
+        let entries: [(Todo, Int, TimeInterval)] = [
+            (mockups, 0, 5_400),
+            (invoices, -1, 7_200),
+            (migration, -1, 2_700),
+            (kickoff, -3, 3_600),
+        ]
+        for (todo, offset, duration) in entries {
+            let start = calendar.date(
+                bySettingHour: 10, minute: 0, second: 0, of: days(offset)
+            ) ?? days(offset)
+            context.insert(TimeEntry(
+                startTime: start,
+                endTime: start.addingTimeInterval(duration),
+                duration: duration,
+                source: .manual,
+                todo: todo
+            ))
+        }
+
+        try context.save()
+    }
+}
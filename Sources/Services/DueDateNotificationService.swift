@@ -0,0 +1,228 @@
+import Foundation
+import SwiftData
+import UserNotifications
+
+enum DueState: String {
+    case dueToday
+    case overdue
+}
+
+@MainActor
+final class DueDateNotificationService {
+    private let modelContainer: ModelContainer
+    private let logService: LogService?
+    private var checkTask: Task<Void, Never>?
+
+    init(modelContainer: ModelContainer, logService: LogService? = nil) {
+        self.modelContainer = modelContainer
+        self.logService = logService
+    }
+
+    // MARK: - Public API
+
+    func start() {
+        // UNUserNotificationCenter traps when the binary isn't running from an app bundle
+        guard Bundle.main.bundleIdentifier != nil else {
+            logService?.log("Due date notifications unavailable outside an app bundle")
+            return
+        }
+        UNUserNotificationCenter.current().requestAuthorization(
+            options: [.alert, .sound]
+        ) { [logService] granted, error in
+            if let error {
+                Task { @MainActor in
+                    logService?.log(
+                        "Notification authorization failed: \(error)",
+                        level: .error
+                    )
+                }
+            } else if !granted {
+                Task { @MainActor in
+                    logService?.log("Notification permission denied")
+                }
+            }
+        }
+
+        checkTask?.cancel()
+        checkTask = Task { [weak self] in
+            while !Task.isCancelled {
+                self?.resurfaceSnoozedTodos()
+                self?.checkDueTodos()
+                self?.checkReminders()
+                try? await Task.sleep(for: .seconds(AppConfig.dueCheckInterval))
+            }
+        }
+    }
+
+    func stop() {
+        checkTask?.cancel()
+        checkTask = nil
+    }
+
+    /// Clears expired snoozes and notifies that the todo is back in the list.
+    func resurfaceSnoozedTodos(now: Date = Date()) {
+        let context = ModelContext(modelContainer)
+        let descriptor = FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.snoozedUntil != nil && todo.deletedAt == nil
+            }
+        )
+        do {
+            let woken = try context.fetch(descriptor).filter {
+                ($0.snoozedUntil ?? .distantFuture) <= now
+            }
+            guard !woken.isEmpty else { return }
+            for todo in woken {
+                todo.snoozedUntil = nil
+                if !todo.isCompleted {
+                    post(
+                        title: "Snooze ended", todo: todo,
+                        identifier: "snooze-\(todo.id.uuidString)"
+                    )
+                }
+            }
+            try context.save()
+        } catch {
+            logService?.log("Snooze check failed: \(error)", level: .error)
+        }
+    }
+
+    /// Notifies once per todo per day when it is due today or has become overdue.
+    func checkDueTodos(now: Date = Date()) {
+        let context = ModelContext(modelContainer)
+        let descriptor = FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.dueDate != nil && todo.isCompleted == false && todo.deletedAt == nil
+            }
+        )
+        let todos: [Todo]
+        do {
+            todos = try context.fetch(descriptor)
+        } catch {
+            logService?.log("Due date check failed: \(error)", level: .error)
+            return
+        }
+        pruneMarkers(keeping: Set(todos.map(\.id.uuidString)))
+
+        let today = Self.dayStamp(for: now)
+        for todo in todos where !todo.isReference && !todo.isSnoozed {
+            guard let dueDate = todo.dueDate,
+                  let state = Self.dueState(for: dueDate, now: now) else { continue }
+
+            let key = "dueNotification.\(todo.id.uuidString)"
+            let marker = "\(today).\(state.rawValue)"
+            guard AppConfig.defaults.string(forKey: key) != marker else { continue }
+            AppConfig.defaults.set(marker, forKey: key)
+
+            let title = state == .dueToday ? "Due today" : "Overdue"
+            post(title: title, todo: todo, identifier: "due-\(todo.id.uuidString)-\(today)")
+        }
+    }
+
+    /// Sends the closest pending lead-time reminder for each upcoming due date.
+    /// Leads whose window has passed are marked sent together, so reopening the
+    /// app after a few days posts a single reminder rather than a burst.
+    func checkReminders(now: Date = Date()) {
+        let context = ModelContext(modelContainer)
+        let descriptor = FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.dueDate != nil && todo.isCompleted == false && todo.deletedAt == nil
+            }
+        )
+        let todos: [Todo]
+        do {
+            todos = try context.fetch(descriptor)
+        } catch {
+            logService?.log("Reminder check failed: \(error)", level: .error)
+            return
+        }
+
+        let calendar = Calendar.current
+        let globalLeadDays = AppConfig.reminderLeadDays
+        for todo in todos where !todo.isReference && !todo.isSnoozed {
+            guard let dueDate = todo.dueDate else { continue }
+            let dueDay = calendar.startOfDay(for: dueDate)
+            guard now < dueDay else { continue }
+
+            let dueStamp = Self.dayStamp(for: dueDay)
+            let pending = (todo.reminderLeadDays ?? globalLeadDays).filter { days in
+                guard let fireDate = calendar.date(byAdding: .day, value: -days, to: dueDay),
+                      now >= fireDate else { return false }
+                let key = Self.reminderKey(todo: todo, days: days)
+                return AppConfig.defaults.string(forKey: key) != dueStamp
+            }
+            guard let closest = pending.min() else { continue }
+
+            for days in pending {
+                AppConfig.defaults.set(dueStamp, forKey: Self.reminderKey(todo: todo, days: days))
+            }
+            let daysLeft = calendar.dateComponents(
+                [.day], from: calendar.startOfDay(for: now), to: dueDay
+            ).day ?? closest
+            let title = daysLeft <= 1 ? "Due tomorrow" : "Due in \(daysLeft) days"
+            post(
+                title: title, todo: todo,
+                identifier: "reminder-\(todo.id.uuidString)-\(dueStamp)-\(closest)"
+            )
+        }
+    }
+
+    static func dueState(for dueDate: Date, now: Date = Date()) -> DueState? {
+        let calendar = Calendar.current
+        let startOfToday = calendar.startOfDay(for: now)
+        if dueDate < startOfToday { return .overdue }
+        if calendar.isDate(dueDate, inSameDayAs: now) { return .dueToday }
+        return nil
+    }
+
+    // MARK: - Private
+
+    /// Drops the notification markers of todos that are no longer open with a
+    /// due date (completed, trashed or purged) so they don't pile up in defaults.
+    private func pruneMarkers(keeping openIDs: Set<String>) {
+        let defaults = AppConfig.defaults
+        for key in defaults.dictionaryRepresentation().keys {
+            let parts = key.split(separator: ".")
+            guard parts.count >= 2,
+                  parts[0] == "dueNotification" || parts[0] == "dueReminder",
+                  !openIDs.contains(String(parts[1])) else { continue }
+            defaults.removeObject(forKey: key)
+        }
+    }
+
+    private func post(title: String, todo: Todo, identifier: String) {
+        let content = UNMutableNotificationContent()
+        content.title = title
+        content.body = todo.title
+        if let project = todo.project {
+            content.subtitle = project.name
+        }
+        content.sound = .default
+
+        let request = UNNotificationRequest(
+            identifier: identifier, content: content, trigger: nil
+        )
+        UNUserNotificationCenter.current().add(request) { [logService] error in
+            guard let error else { return }
+            Task { @MainActor in
+                logService?.log(
+                    "Failed to post notification: \(error)",
+                    level: .error
+                )
+            }
+        }
+        logService?.log("Posted \"\(title)\" notification for \"\(todo.title)\"")
+    }
+
+    private static func reminderKey(todo: Todo, days: Int) -> String {
+        "dueReminder.\(todo.id.uuidString).\(days)"
+    }
+
+    private static func dayStamp(for date: Date) -> String {
+        let components = Calendar.current.dateComponents([.year, .month, .day], from: date)
+        return String(
+            format: "%04d-%02d-%02d",
+            components.year ?? 0, components.month ?? 0, components.day ?? 0
+        )
+    }
+}
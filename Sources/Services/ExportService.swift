@@ -12,25 +12,19 @@ struct ExportResult {
     let totalDuration: TimeInterval
 }
 
+struct WorklogDraft: Identifiable {
+    var id: String { ticketID }
+    let ticketID: String
+    let started: Date
+    let duration: TimeInterval
+    let roundedDuration: TimeInterval
+    let entryIDs: [UUID]
+}
+
 @ModelActor
 actor ExportService: ExportServiceProtocol {
     func generateExport(for date: Date) throws -> ExportResult {
-        let calendar = Calendar.current
-        let startOfDay = calendar.startOfDay(for: date)
-        let endOfDay = calendar.date(byAdding: .day, value: 1, to: startOfDay)!
-
-        let reviewedStatus = BookingStatus.reviewed
-        let predicate = #Predicate<TimeEntry> {
-            $0.startTime >= startOfDay
-                && $0.startTime < endOfDay
-                && $0.bookingStatus == reviewedStatus
-                && $0.isExcluded == false
-        }
-        let descriptor = FetchDescriptor<TimeEntry>(
-            predicate: predicate,
-            sortBy: [SortDescriptor(\.startTime, order: .forward)]
-        )
-        let entries = try modelContext.fetch(descriptor)
+        let entries = try reviewedEntries(for: date)
 
         guard !entries.isEmpty else {
             return ExportResult(
@@ -94,6 +88,33 @@ actor ExportService: ExportServiceProtocol {
         )
     }
 
+    /// Groups reviewed entries with a Jira ticket into one worklog per ticket,
+    /// rounding each total up to the next `roundingMinutes` increment.
+    func generateWorklogs(for date: Date, roundingMinutes: Int) throws -> [WorklogDraft] {
+        let entries = try reviewedEntries(for: date)
+        let increment = TimeInterval(max(roundingMinutes, 1) * 60)
+
+        var grouped: [String: [TimeEntry]] = [:]
+        for entry in entries {
+            guard let ticketID = entry.ticketID,
+                  ticketID.wholeMatch(of: #/[A-Z][A-Z0-9]+-\d+/#) != nil else { continue }
+            grouped[ticketID, default: []].append(entry)
+        }
+
+        return grouped.compactMap { ticketID, ticketEntries in
+            let duration = ticketEntries.reduce(0.0) { $0 + $1.duration }
+            guard duration >= 60 else { return nil }
+            return WorklogDraft(
+                ticketID: ticketID,
+                started: ticketEntries.map(\.startTime).min() ?? date,
+                duration: duration,
+                roundedDuration: (duration / increment).rounded(.up) * increment,
+                entryIDs: ticketEntries.map(\.id)
+            )
+        }
+        .sorted { $0.roundedDuration > $1.roundedDuration }
+    }
+
     func checkDuplicates(entryIDs: [UUID]) throws -> [UUID] {
         let exportedStatus = BookingStatus.exported
         let bookedStatus = BookingStatus.booked
@@ -146,4 +167,35 @@ actor ExportService: ExportServiceProtocol {
         try modelContext.save()
     }
 
+    func markBooked(entryIDs: [UUID]) throws {
+        for entryID in entryIDs {
+            let predicate = #Predicate<TimeEntry> { $0.id == entryID }
+            let descriptor = FetchDescriptor<TimeEntry>(predicate: predicate)
+            if let entry = try modelContext.fetch(descriptor).first {
+                entry.bookingStatus = .booked
+            }
+        }
+        try modelContext.save()
+    }
+
+    // MARK: - Private
+
+    private func reviewedEntries(for date: Date) throws -> [TimeEntry] {
+        let calendar = Calendar.current
+        let startOfDay = calendar.startOfDay(for: date)
+        let endOfDay = calendar.date(byAdding: .day, value: 1, to: startOfDay)!
+
+        let reviewedStatus = BookingStatus.reviewed
+        let predicate = #Predicate<TimeEntry> {
+            $0.startTime >= startOfDay
+                && $0.startTime < endOfDay
+                && $0.bookingStatus == reviewedStatus
+                && $0.isExcluded == false
+        }
+        let descriptor = FetchDescriptor<TimeEntry>(
+            predicate: predicate,
+            sortBy: [SortDescriptor(\.startTime, order: .forward)]
+        )
+        return try modelContext.fetch(descriptor)
+    }
 }
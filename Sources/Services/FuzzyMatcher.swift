@@ -0,0 +1,57 @@
+import Foundation
+
+/// Subsequence matcher used by Quick Open, plus trigram similarity for
+/// duplicate detection. Higher scores are better matches.
+enum FuzzyMatcher {
+    /// Returns nil when `query` isn't a case-insensitive subsequence of `candidate`.
+    /// Consecutive characters and matches at word starts score higher;
+    /// gaps and long candidates score lower.
+    static func score(_ query: String, in candidate: String) -> Int? {
+        let needle = Array(query.lowercased().filter { !$0.isWhitespace })
+        guard !needle.isEmpty else { return 0 }
+        let haystack = Array(candidate.lowercased())
+
+        var score = 0
+        var needleIndex = 0
+        var previousMatch: Int?
+        for (index, character) in haystack.enumerated() where needleIndex < needle.count {
+            guard character == needle[needleIndex] else { continue }
+
+            score += 1
+            if let previousMatch {
+                if previousMatch == index - 1 {
+                    score += 5
+                } else {
+                    score -= min(index - previousMatch - 1, 3)
+                }
+            }
+            if index == 0 || !haystack[index - 1].isLetter && !haystack[index - 1].isNumber {
+                score += 8
+            }
+            previousMatch = index
+            needleIndex += 1
+        }
+
+        guard needleIndex == needle.count else { return nil }
+        return score * 10 - haystack.count / 4
+    }
+
+    /// Jaccard similarity of the two strings' character trigrams, from 0 to 1.
+    /// Case, punctuation and repeated whitespace are ignored.
+    static func similarity(_ lhs: String, _ rhs: String) -> Double {
+        let left = trigrams(lhs)
+        let right = trigrams(rhs)
+        guard !left.isEmpty, !right.isEmpty else { return 0 }
+        return Double(left.intersection(right).count) / Double(left.union(right).count)
+    }
+
+    private static func trigrams(_ text: String) -> Set<String> {
+        let words = text.lowercased()
+            .components(separatedBy: CharacterSet.alphanumerics.inverted)
+            .filter { !$0.isEmpty }
+        guard !words.isEmpty else { return [] }
+        let padded = Array("  " + words.joined(separator: " ") + " ")
+        guard padded.count >= 3 else { return [] }
+        return Set((0...(padded.count - 3)).map { String(padded[$0..<$0 + 3]) })
+    }
+}
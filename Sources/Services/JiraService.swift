@@ -12,14 +12,55 @@ struct JiraTicketInfo: Identifiable {
     let issueType: String?
     let projectKey: String?
     let projectName: String?
+    let dueDate: Date?
+    let labels: [String]
+    let watchCount: Int
+    let isWatching: Bool
+    let votes: Int
+    let hasVoted: Bool
     let browseURL: URL?
-    let fetchedAt: Date
+    var fetchedAt: Date
+}
+
+struct JiraWorklog: Identifiable {
+    let id: String
+    let author: String
+    let started: Date
+    let timeSpent: TimeInterval
+    let comment: String
+}
+
+struct JiraCreatedIssue {
+    let key: String
+    let serverURL: String
+}
+
+enum JiraServiceError: Error, LocalizedError {
+    case notConfigured
+    case invalidURL
+    case invalidResponse
+    case requestFailed(statusCode: Int, message: String?)
+
+    var errorDescription: String? {
+        switch self {
+        case .notConfigured:
+            "Jira integration is not configured"
+        case .invalidURL:
+            "Invalid Jira server URL"
+        case .invalidResponse:
+            "Unexpected response from Jira"
+        case .requestFailed(let statusCode, let message):
+            message.map { "Jira returned HTTP \(statusCode): \($0)" }
+                ?? "Jira returned HTTP \(statusCode)"
+        }
+    }
 }
 
 @MainActor @Observable
 final class JiraService: JiraServiceProtocol {
     private var cache: [String: JiraTicketInfo] = [:]
     private var inFlight: [String: Task<JiraTicketInfo?, Never>] = [:]
+    private var validators: [String: HTTPValidators] = [:]
     private var cacheTTL: TimeInterval { AppConfig.jiraCacheTTL }
     private(set) var projectNames: [String: String] = [:]
 
@@ -34,7 +75,7 @@ final class JiraService: JiraServiceProtocol {
     func ticketInfo(for ticketID: String) async -> JiraTicketInfo? {
         if let cached = cache[ticketID],
            Date().timeIntervalSince(cached.fetchedAt) < cacheTTL {
-            logService?.log("Cache hit for \(ticketID)")
+            logService?.log("Cache hit for \(ticketID)", level: .debug)
             return cached
         }
 
@@ -81,8 +122,221 @@ final class JiraService: JiraServiceProtocol {
         projectNames[projectKey]
     }
 
+    func addWorklog(
+        ticketID: String, started: Date, timeSpent: TimeInterval, comment: String
+    ) async throws {
+        let isCloud = loadCredentials()?.isCloud ?? false
+        var body: [String: Any] = [
+            "started": Formatters.jiraTimestamp.string(from: started),
+            "timeSpentSeconds": Int(timeSpent),
+        ]
+        if !comment.isEmpty {
+            body["comment"] = isCloud ? Self.adfDocument(comment) : comment
+        }
+        _ = try await send(
+            method: "POST", path: "/issue/\(ticketID)/worklog", body: body
+        )
+        logService?.log(
+            "Logged \(timeSpent.hoursMinutes) to \(ticketID)"
+        )
+    }
+
+    func worklogs(for ticketID: String) async throws -> [JiraWorklog] {
+        let data = try await send(method: "GET", path: "/issue/\(ticketID)/worklog")
+        guard let json = try? JSONSerialization.jsonObject(with: data) as? [String: Any],
+              let entries = json["worklogs"] as? [[String: Any]] else {
+            throw JiraServiceError.invalidResponse
+        }
+        return entries.compactMap { entry in
+            guard let id = entry["id"] as? String,
+                  let startedString = entry["started"] as? String,
+                  let started = Formatters.jiraTimestamp.date(from: startedString),
+                  let seconds = entry["timeSpentSeconds"] as? Int else {
+                return nil
+            }
+            let author = (entry["author"] as? [String: Any])?["displayName"] as? String
+            // Cloud returns comments as ADF, Server as plain text
+            let comment = (entry["comment"] as? String)
+                ?? Self.plainText(fromADF: entry["comment"])
+            return JiraWorklog(
+                id: id,
+                author: author ?? "Unknown",
+                started: started,
+                timeSpent: TimeInterval(seconds),
+                comment: comment
+            )
+        }
+        .sorted { $0.started > $1.started }
+    }
+
+    func setWatching(_ watching: Bool, ticketID: String) async throws {
+        if watching {
+            // Without a body Jira adds the calling user
+            _ = try await send(method: "POST", path: "/issue/\(ticketID)/watchers")
+        } else {
+            let isCloud = loadCredentials()?.isCloud ?? false
+            let user = try await currentUserIdentifier(isCloud: isCloud)
+            _ = try await send(
+                method: "DELETE", path: "/issue/\(ticketID)/watchers",
+                query: [URLQueryItem(name: isCloud ? "accountId" : "username", value: user)]
+            )
+        }
+        cache.removeValue(forKey: ticketID)
+        logService?.log("\(watching ? "Watching" : "Stopped watching") \(ticketID)")
+    }
+
+    func setVoted(_ voted: Bool, ticketID: String) async throws {
+        _ = try await send(
+            method: voted ? "POST" : "DELETE", path: "/issue/\(ticketID)/votes"
+        )
+        cache.removeValue(forKey: ticketID)
+        logService?.log("\(voted ? "Voted for" : "Removed vote from") \(ticketID)")
+    }
+
+    func createIssue(
+        projectKey: String, issueType: String, summary: String,
+        description: String, priority: String?
+    ) async throws -> JiraCreatedIssue {
+        guard let credentials = loadCredentials() else {
+            throw JiraServiceError.notConfigured
+        }
+        var fields: [String: Any] = [
+            "project": ["key": projectKey],
+            "issuetype": ["name": issueType],
+            "summary": summary,
+        ]
+        if !description.isEmpty {
+            fields["description"] = credentials.isCloud
+                ? Self.adfDocument(description) : description
+        }
+        if let priority {
+            fields["priority"] = ["name": priority]
+        }
+        let data = try await send(method: "POST", path: "/issue", body: ["fields": fields])
+        guard let json = try? JSONSerialization.jsonObject(with: data) as? [String: Any],
+              let key = json["key"] as? String else {
+            throw JiraServiceError.invalidResponse
+        }
+        logService?.log("Created \(key) in \(projectKey)")
+        let serverURL = credentials.serverURL
+            .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
+        return JiraCreatedIssue(key: key, serverURL: serverURL)
+    }
+
     // MARK: - Private
 
+    /// Cloud identifies users by account ID, Server/Data Center by username.
+    private func currentUserIdentifier(isCloud: Bool) async throws -> String {
+        let data = try await send(method: "GET", path: "/myself")
+        guard let json = try? JSONSerialization.jsonObject(with: data) as? [String: Any],
+              let identifier = json[isCloud ? "accountId" : "name"] as? String else {
+            throw JiraServiceError.invalidResponse
+        }
+        return identifier
+    }
+
+    /// Sends an authenticated request to `<server><apiPath><path>` and returns
+    /// the response body, throwing on non-2xx responses.
+    private func send(
+        method: String, path: String, query: [URLQueryItem] = [],
+        body: [String: Any]? = nil
+    ) async throws -> Data {
+        guard let credentials = loadCredentials() else {
+            throw JiraServiceError.notConfigured
+        }
+        let baseURL = credentials.serverURL
+            .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
+        let apiPath = Self.apiPath(isCloud: credentials.isCloud)
+        guard var components = URLComponents(string: "\(baseURL)\(apiPath)\(path)") else {
+            throw JiraServiceError.invalidURL
+        }
+        if !query.isEmpty {
+            components.percentEncodedQueryItems = query.map { item in
+                URLQueryItem(
+                    name: item.name,
+                    value: item.value?.addingPercentEncoding(withAllowedCharacters: Self.queryValueAllowed)
+                )
+            }
+        }
+        guard let url = components.url else {
+            throw JiraServiceError.invalidURL
+        }
+
+        var request = URLRequest(url: url)
+        request.httpMethod = method
+        request.setValue("application/json", forHTTPHeaderField: "Accept")
+        request.setAtlassianAuthorization(
+            token: credentials.token,
+            username: credentials.username,
+            isCloud: credentials.isCloud
+        )
+        if let body {
+            request.setValue("application/json", forHTTPHeaderField: "Content-Type")
+            request.httpBody = try JSONSerialization.data(withJSONObject: body)
+        }
+
+        logService?.log("\(method) \(url.absoluteString)")
+        let (data, response) = try await URLSession.shared.data(for: request)
+        guard let http = response as? HTTPURLResponse else {
+            throw JiraServiceError.requestFailed(statusCode: 0, message: nil)
+        }
+        guard (200..<300).contains(http.statusCode) else {
+            if http.statusCode == 401 || http.statusCode == 403 {
+                KeychainService.invalidateCache()
+            }
+            let message = Self.errorMessage(from: data)
+            logService?.log(
+                "HTTP \(http.statusCode) for \(method) \(path): \(message ?? "no details")",
+                level: .error
+            )
+            throw JiraServiceError.requestFailed(
+                statusCode: http.statusCode, message: message
+            )
+        }
+        return data
+    }
+
+    /// URLQueryItem leaves `&`, `+` and `=` alone, which would split or
+    /// garble values like usernames, so they are escaped as well.
+    private static let queryValueAllowed = CharacterSet.urlQueryAllowed
+        .subtracting(CharacterSet(charactersIn: "&+=?#"))
+
+    /// Wraps plain text in a minimal Atlassian Document Format paragraph, as
+    /// required by rich-text fields on the Cloud v3 API.
+    nonisolated static func adfDocument(_ text: String) -> [String: Any] {
+        [
+            "type": "doc",
+            "version": 1,
+            "content": [
+                [
+                    "type": "paragraph",
+                    "content": [["type": "text", "text": text]],
+                ],
+            ],
+        ]
+    }
+
+    /// Flattens the text nodes of an Atlassian Document Format value,
+    /// one line per paragraph.
+    nonisolated static func plainText(fromADF value: Any?) -> String {
+        guard let node = value as? [String: Any] else { return "" }
+        if let text = node["text"] as? String { return text }
+        let children = (node["content"] as? [Any] ?? []).map { plainText(fromADF: $0) }
+        let separator = node["type"] as? String == "doc" ? "\n" : ""
+        return children.joined(separator: separator)
+    }
+
+    private static func errorMessage(from data: Data) -> String? {
+        guard let json = try? JSONSerialization.jsonObject(with: data) as? [String: Any] else {
+            return String(data: data, encoding: .utf8).map { String($0.prefix(300)) }
+        }
+        var messages = json["errorMessages"] as? [String] ?? []
+        if let errors = json["errors"] as? [String: String] {
+            messages += errors.map { "\($0.key): \($0.value)" }
+        }
+        return messages.isEmpty ? nil : messages.joined(separator: "; ")
+    }
+
     private func cacheProjectName(from info: JiraTicketInfo) {
         if let key = info.projectKey, let name = info.projectName {
             projectNames[key] = name
@@ -91,15 +345,16 @@ final class JiraService: JiraServiceProtocol {
 
     private func fetchFromJira(ticketID: String) async -> JiraTicketInfo? {
         guard let credentials = loadCredentials() else {
-            logService?.log("No credentials found for \(ticketID)", level: .error)
+            logService?.log("No credentials found for \(ticketID)", level: .warning)
             return nil
         }
 
         let baseURL = credentials.serverURL
             .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
-        let fields = "summary,status,assignee,priority,issuetype,project"
-        let urlString = "\(baseURL)/rest/api/2/issue/\(ticketID)?fields=\(fields)"
-        logService?.log("Fetching \(urlString)")
+        let fields = "summary,status,assignee,priority,issuetype,project,duedate,labels,watches,votes"
+        let apiPath = Self.apiPath(isCloud: credentials.isCloud)
+        let urlString = "\(baseURL)\(apiPath)/issue/\(ticketID)?fields=\(fields)"
+        logService?.log("Fetching \(urlString)", level: .debug)
 
         guard let url = URL(string: urlString) else {
             logService?.log("Invalid URL: \(urlString)", level: .error)
@@ -109,8 +364,14 @@ final class JiraService: JiraServiceProtocol {
         var request = URLRequest(url: url)
         request.httpMethod = "GET"
         request.setValue("application/json", forHTTPHeaderField: "Accept")
-
-        request.setValue("Bearer \(credentials.token)", forHTTPHeaderField: "Authorization")
+        request.setAtlassianAuthorization(
+            token: credentials.token,
+            username: credentials.username,
+            isCloud: credentials.isCloud
+        )
+        request.setConditionalHeaders(
+            cache[ticketID] == nil ? nil : validators[urlString]
+        )
 
         do {
             let (data, response) = try await URLSession.shared.data(for: request)
@@ -119,7 +380,15 @@ final class JiraService: JiraServiceProtocol {
                 return nil
             }
             logService?.log("HTTP \(httpResponse.statusCode) for \(ticketID)")
+            if httpResponse.statusCode == 304, var cached = cache[ticketID] {
+                logService?.log("Not modified: \(ticketID)", level: .debug)
+                cached.fetchedAt = Date()
+                return cached
+            }
             guard httpResponse.statusCode == 200 else {
+                if httpResponse.statusCode == 401 || httpResponse.statusCode == 403 {
+                    KeychainService.invalidateCache()
+                }
                 if let body = String(data: data, encoding: .utf8) {
                     logService?.log(
                         "Response body: \(String(body.prefix(300)))",
@@ -128,6 +397,7 @@ final class JiraService: JiraServiceProtocol {
                 }
                 return nil
             }
+            validators[urlString] = HTTPValidators(response: httpResponse)
             return parseResponse(data: data, ticketID: ticketID, baseURL: baseURL)
         } catch {
             logService?.log("Error: \(error.localizedDescription)", level: .error)
@@ -135,9 +405,16 @@ final class JiraService: JiraServiceProtocol {
         }
     }
 
+    /// Cloud exposes the v3 REST API; Server/Data Center stays on v2.
+    nonisolated static func apiPath(isCloud: Bool) -> String {
+        isCloud ? "/rest/api/3" : "/rest/api/2"
+    }
+
     private struct JiraCredentials {
         let serverURL: String
         let token: String
+        let username: String
+        let isCloud: Bool
     }
 
     @MainActor
@@ -155,20 +432,23 @@ final class JiraService: JiraServiceProtocol {
             )
             return nil
         }
-        logService?.log("All configs: \(allConfigs.map { "type=\($0.type.rawValue) url=\($0.serverURL) enabled=\($0.isEnabled)" })")
+        logService?.log("All configs: \(allConfigs.map { "type=\($0.type.rawValue) url=\($0.serverURL) enabled=\($0.isEnabled) cloud=\($0.isCloud)" })", level: .debug)
 
         let token = try? KeychainService.retrieve(key: "jira_token")
-        logService?.log("Keychain token present: \(token != nil && !token!.isEmpty)")
+        logService?.log("Keychain token present: \(token != nil && !token!.isEmpty)", level: .debug)
 
         guard let config = allConfigs.first(where: { $0.type == .jira && $0.isEnabled }),
               !config.serverURL.isEmpty,
-              let token, !token.isEmpty else {
-            logService?.log("Credential check failed", level: .error)
+              let token, !token.isEmpty,
+              !config.isCloud || !config.username.isEmpty else {
+            logService?.log("Credential check failed", level: .warning)
             return nil
         }
         return JiraCredentials(
             serverURL: config.serverURL,
-            token: token
+            token: token,
+            username: config.username,
+            isCloud: config.isCloud
         )
     }
 
@@ -222,6 +502,13 @@ final class JiraService: JiraServiceProtocol {
             projectName = nil
         }
 
+        // Jira sends duedate as a bare yyyy-MM-dd day
+        let dueDate = (fields["duedate"] as? String)
+            .flatMap { Formatters.jiraDay.date(from: $0) }
+        let labels = fields["labels"] as? [String] ?? []
+        let watches = fields["watches"] as? [String: Any]
+        let votes = fields["votes"] as? [String: Any]
+
         let browseURL = URL(string: "\(baseURL)/browse/\(ticketID)")
 
         let info = JiraTicketInfo(
@@ -234,6 +521,12 @@ final class JiraService: JiraServiceProtocol {
             issueType: issueType,
             projectKey: projectKey,
             projectName: projectName,
+            dueDate: dueDate,
+            labels: labels,
+            watchCount: watches?["watchCount"] as? Int ?? 0,
+            isWatching: watches?["isWatching"] as? Bool ?? false,
+            votes: votes?["votes"] as? Int ?? 0,
+            hasVoted: votes?["hasVoted"] as? Bool ?? false,
             browseURL: browseURL,
             fetchedAt: Date()
         )
@@ -1,7 +1,25 @@
 import Foundation
 
 struct KeychainService {
+    /// How long the decoded credentials file is reused before re-reading it.
+    static let cacheTTL: TimeInterval = 300
+
+    private static let cacheLock = NSLock()
+    nonisolated(unsafe) private static var cachedStore: [String: String]?
+    nonisolated(unsafe) private static var cachedAt: Date?
+
     private static let credentialsURL: URL = {
+        if AppConfig.isDemoMode {
+            // Demo launches start without credentials and discard any saved
+            // ones, so the user's real tokens are never read or overwritten
+            let dir = FileManager.default.temporaryDirectory
+                .appendingPathComponent("TaskManagement-demo", isDirectory: true)
+            try? FileManager.default.removeItem(at: dir)
+            try? FileManager.default.createDirectory(
+                at: dir, withIntermediateDirectories: true
+            )
+            return dir.appendingPathComponent("credentials.json")
+        }
         let appSupport = FileManager.default.urls(
             for: .applicationSupportDirectory, in: .userDomainMask
         ).first!
@@ -21,9 +39,7 @@ struct KeychainService {
     ) throws {
         var store = try loadStore()
         store[key] = value
-        let data = try JSONEncoder().encode(store)
-        try data.write(to: credentialsURL, options: .atomic)
-        try setFilePermissions()
+        try writeStore(store)
     }
 
     static func retrieve(
@@ -39,19 +55,51 @@ struct KeychainService {
     ) throws {
         var store = try loadStore()
         store.removeValue(forKey: key)
+        try writeStore(store)
+    }
+
+    /// Drops the in-memory copy so the next read goes back to disk.
+    /// Call after an integration rejects its credentials (HTTP 401/403).
+    static func invalidateCache() {
+        cacheLock.withLock {
+            cachedStore = nil
+            cachedAt = nil
+        }
+    }
+
+    private static func loadStore() throws -> [String: String] {
+        if let cached = cacheLock.withLock({ () -> [String: String]? in
+            guard let cachedAt, Date().timeIntervalSince(cachedAt) < cacheTTL else {
+                return nil
+            }
+            return cachedStore
+        }) {
+            return cached
+        }
+
+        var store: [String: String] = [:]
+        if FileManager.default.fileExists(atPath: credentialsURL.path) {
+            let data = try Data(contentsOf: credentialsURL)
+            store = try JSONDecoder().decode(
+                [String: String].self, from: data
+            )
+        }
+        cache(store)
+        return store
+    }
+
+    private static func writeStore(_ store: [String: String]) throws {
         let data = try JSONEncoder().encode(store)
         try data.write(to: credentialsURL, options: .atomic)
         try setFilePermissions()
+        cache(store)
     }
 
-    private static func loadStore() throws -> [String: String] {
-        guard FileManager.default.fileExists(
-            atPath: credentialsURL.path
-        ) else { return [:] }
-        let data = try Data(contentsOf: credentialsURL)
-        return try JSONDecoder().decode(
-            [String: String].self, from: data
-        )
+    private static func cache(_ store: [String: String]) {
+        cacheLock.withLock {
+            cachedStore = store
+            cachedAt = Date()
+        }
     }
 
     private static func setFilePermissions() throws {
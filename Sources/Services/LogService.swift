@@ -1,8 +1,43 @@
 import SwiftUI
 
-enum LogLevel: String {
+enum LogLevel: String, CaseIterable, Identifiable, Comparable {
+    case debug = "DEBUG"
     case info = "INFO"
+    case warning = "WARN"
     case error = "ERROR"
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .debug: "Debug"
+        case .info: "Info"
+        case .warning: "Warning"
+        case .error: "Error"
+        }
+    }
+
+    var color: Color {
+        switch self {
+        case .debug: .secondary
+        case .info: .blue
+        case .warning: .orange
+        case .error: .red
+        }
+    }
+
+    private var severity: Int {
+        switch self {
+        case .debug: 0
+        case .info: 1
+        case .warning: 2
+        case .error: 3
+        }
+    }
+
+    static func < (lhs: LogLevel, rhs: LogLevel) -> Bool {
+        lhs.severity < rhs.severity
+    }
 }
 
 struct LogEntry: Identifiable {
@@ -16,6 +51,13 @@ struct LogEntry: Identifiable {
 final class LogService {
     private(set) var entries: [LogEntry] = []
     private var maxEntries: Int { AppConfig.maxLogEntries }
+    private let fileSink: LogFileSink?
+
+    init(fileSink: LogFileSink? = LogFileSink()) {
+        self.fileSink = fileSink
+    }
+
+    var logFileURL: URL? { fileSink?.fileURL }
 
     func log(_ message: String, level: LogLevel = .info) {
         let entry = LogEntry(timestamp: Date(), message: message, level: level)
@@ -24,6 +66,72 @@ final class LogService {
             entries.removeFirst(entries.count - maxEntries)
         }
         print("[\(level.rawValue)] \(message)")
+        fileSink?.write(entry)
+    }
+}
+
+// MARK: - File Sink
+
+/// Appends log lines to `Application Support/TaskManagement/Logs/app.log`.
+/// When the file grows past `maxFileSize` it is renamed to `app.1.log`
+/// (replacing the previous one) and a fresh file is started.
+final class LogFileSink: @unchecked Sendable {
+    let fileURL: URL
+    private let maxFileSize: Int
+    private let queue = DispatchQueue(label: "LogFileSink")
+
+    private static let lineFormatter: ISO8601DateFormatter = {
+        let formatter = ISO8601DateFormatter()
+        formatter.formatOptions = [.withInternetDateTime, .withFractionalSeconds]
+        return formatter
+    }()
+
+    static let logsDirectory: URL = {
+        let appSupport = FileManager.default.urls(
+            for: .applicationSupportDirectory, in: .userDomainMask
+        ).first!
+        let dir = appSupport
+            .appendingPathComponent("TaskManagement", isDirectory: true)
+            .appendingPathComponent("Logs", isDirectory: true)
+        try? FileManager.default.createDirectory(
+            at: dir, withIntermediateDirectories: true
+        )
+        return dir
+    }()
+
+    init(
+        fileURL: URL = LogFileSink.logsDirectory.appendingPathComponent("app.log"),
+        maxFileSize: Int = 1_000_000
+    ) {
+        self.fileURL = fileURL
+        self.maxFileSize = maxFileSize
+    }
+
+    func write(_ entry: LogEntry) {
+        let line = "\(Self.lineFormatter.string(from: entry.timestamp)) "
+            + "[\(entry.level.rawValue)] \(entry.message)\n"
+        queue.async { [self] in
+            rotateIfNeeded()
+            guard let data = line.data(using: .utf8) else { return }
+            if let handle = try? FileHandle(forWritingTo: fileURL) {
+                defer { try? handle.close() }
+                _ = try? handle.seekToEnd()
+                try? handle.write(contentsOf: data)
+            } else {
+                try? data.write(to: fileURL)
+            }
+        }
+    }
+
+    private func rotateIfNeeded() {
+        let fm = FileManager.default
+        guard let size = (try? fm.attributesOfItem(atPath: fileURL.path))?[.size] as? Int,
+              size > maxFileSize else { return }
+        let rotated = fileURL.deletingPathExtension()
+            .appendingPathExtension("1")
+            .appendingPathExtension(fileURL.pathExtension)
+        try? fm.removeItem(at: rotated)
+        try? fm.moveItem(at: fileURL, to: rotated)
     }
 }
 
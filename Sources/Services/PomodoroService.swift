@@ -0,0 +1,133 @@
+import Foundation
+import SwiftData
+import UserNotifications
+
+enum PomodoroPhase: String {
+    case focus
+    case shortBreak
+
+    var label: String {
+        switch self {
+        case .focus: "Focus"
+        case .shortBreak: "Break"
+        }
+    }
+}
+
+/// Runs one focus/break cycle for a todo. A finished focus phase is logged as
+/// a timer entry on that todo, and each phase change posts a notification.
+@MainActor @Observable
+final class PomodoroService {
+    private(set) var phase: PomodoroPhase?
+    private(set) var endsAt: Date?
+    private(set) var todoTitle: String?
+    private(set) var todoID: PersistentIdentifier?
+
+    private var focusStartedAt: Date?
+    private var ticketID: String?
+    private var tickTask: Task<Void, Never>?
+
+    private let modelContainer: ModelContainer
+    private let logService: LogService?
+
+    init(modelContainer: ModelContainer, logService: LogService? = nil) {
+        self.modelContainer = modelContainer
+        self.logService = logService
+    }
+
+    var isRunning: Bool { phase != nil }
+
+    func isRunning(for todo: Todo) -> Bool {
+        isRunning && todoID == todo.persistentModelID
+    }
+
+    func start(todo: Todo) {
+        stop()
+        todoID = todo.persistentModelID
+        todoTitle = todo.title
+        ticketID = todo.jiraLink?.ticketID
+        focusStartedAt = Date()
+        begin(.focus, minutes: AppConfig.pomodoroFocusMinutes)
+        logService?.log("Pomodoro started for \"\(todo.title)\"")
+    }
+
+    /// Stops the cycle. Time already spent in a focus phase is still logged.
+    func stop() {
+        if phase == .focus {
+            logFocusSession(endedAt: Date())
+        }
+        tickTask?.cancel()
+        tickTask = nil
+        phase = nil
+        endsAt = nil
+        todoID = nil
+        todoTitle = nil
+        ticketID = nil
+        focusStartedAt = nil
+    }
+
+    // MARK: - Private
+
+    private func begin(_ next: PomodoroPhase, minutes: Double) {
+        phase = next
+        let end = Date().addingTimeInterval(minutes * 60)
+        endsAt = end
+        tickTask?.cancel()
+        tickTask = Task { [weak self] in
+            try? await Task.sleep(for: .seconds(end.timeIntervalSinceNow))
+            guard !Task.isCancelled else { return }
+            self?.phaseEnded()
+        }
+    }
+
+    private func phaseEnded() {
+        switch phase {
+        case .focus:
+            logFocusSession(endedAt: endsAt ?? Date())
+            notify("Focus session done", body: "Take a short break.")
+            begin(.shortBreak, minutes: AppConfig.pomodoroBreakMinutes)
+        case .shortBreak:
+            notify("Break over", body: todoTitle ?? "Back to work.")
+            stop()
+        case nil:
+            break
+        }
+    }
+
+    private func logFocusSession(endedAt end: Date) {
+        guard let start = focusStartedAt, end.timeIntervalSince(start) >= 60 else { return }
+        focusStartedAt = nil
+        let service = TimeEntryService(modelContainer: modelContainer)
+        let todoID = todoID
+        let label = todoTitle.map { "Pomodoro: \($0)" }
+        let ticketID = ticketID
+        Task { [logService] in
+            do {
+                let entryID = try await service.create(
+                    todoID: todoID,
+                    source: .timer,
+                    startTime: start,
+                    label: label,
+                    ticketID: ticketID
+                )
+                try await service.finalize(entryID: entryID, endTime: end)
+            } catch {
+                logService?.log("Failed to log pomodoro: \(error)", level: .error)
+            }
+        }
+    }
+
+    private func notify(_ title: String, body: String) {
+        logService?.log("Pomodoro: \(title)")
+        // UNUserNotificationCenter traps when the binary isn't running from an app bundle
+        guard Bundle.main.bundleIdentifier != nil else { return }
+        let content = UNMutableNotificationContent()
+        content.title = title
+        content.body = body
+        content.sound = .default
+        let request = UNNotificationRequest(
+            identifier: "pomodoro.\(UUID().uuidString)", content: content, trigger: nil
+        )
+        UNUserNotificationCenter.current().add(request)
+    }
+}
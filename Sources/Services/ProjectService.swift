@@ -16,6 +16,7 @@ struct ProjectService: ProjectServiceProtocol {
         guard try !nameExists(trimmed) else {
             throw ValidationError.duplicateName(trimmed)
         }
+        guard color.isValidHexColor else { throw ValidationError.invalidColor(color) }
 
         let project = Project(
             name: trimmed,
@@ -29,39 +30,95 @@ struct ProjectService: ProjectServiceProtocol {
 
     func update(
         _ project: Project, name: String? = nil, color: String? = nil,
-        descriptionText: String? = nil
+        icon: String? = nil, descriptionText: String? = nil
     ) throws {
+        if let color, !color.isValidHexColor {
+            throw ValidationError.invalidColor(color)
+        }
         if let name {
             let trimmed = name.trimmingCharacters(in: .whitespacesAndNewlines)
-            let exists = trimmed == project.name ? false : try nameExists(trimmed)
-            let allowed = !trimmed.isEmpty && (trimmed == project.name || !exists)
-            if allowed {
-                project.name = trimmed
+            guard !trimmed.isEmpty else { throw ValidationError.emptyName }
+            guard try !nameExists(trimmed, excluding: project) else {
+                throw ValidationError.duplicateName(trimmed)
             }
+            project.name = trimmed
         }
         if let color { project.color = color }
+        if let icon { project.icon = icon }
         if let descriptionText { project.descriptionText = descriptionText }
     }
 
+    /// Moves the project and its live todos to the trash with a shared
+    /// timestamp, so restoring brings back exactly those todos.
     func delete(_ project: Project) {
+        let now = Date()
+        project.deletedAt = now
+        for todo in project.todos where todo.deletedAt == nil {
+            todo.deletedAt = now
+            todo.updatedAt = now
+        }
+    }
+
+    /// Throws `duplicateName` when a live project took the name while this
+    /// one was in the trash.
+    func restore(_ project: Project) throws {
+        guard try !nameExists(project.name, excluding: project) else {
+            throw ValidationError.duplicateName(project.name)
+        }
+        if let deletedAt = project.deletedAt {
+            for todo in project.todos where todo.deletedAt == deletedAt {
+                todo.deletedAt = nil
+                todo.updatedAt = Date()
+            }
+        }
+        project.deletedAt = nil
+    }
+
+    func permanentlyDelete(_ project: Project) {
         for todo in project.todos {
             todo.project = nil
         }
         context.delete(project)
     }
 
+    func purgeExpired() throws -> Int {
+        let cutoff = Calendar.current.date(byAdding: .day, value: -AppConfig.todoPurgeDays, to: Date())!
+        let descriptor = FetchDescriptor<Project>(
+            predicate: #Predicate { project in
+                project.deletedAt != nil && project.deletedAt! < cutoff
+            }
+        )
+        let expired = try context.fetch(descriptor)
+        for project in expired {
+            permanentlyDelete(project)
+        }
+        return expired.count
+    }
+
     func list() throws -> [Project] {
         let descriptor = FetchDescriptor<Project>(
+            predicate: #Predicate { $0.deletedAt == nil },
             sortBy: [SortDescriptor(\.sortOrder), SortDescriptor(\.name)]
         )
         return try context.fetch(descriptor)
     }
 
-    private func nameExists(_ name: String) throws -> Bool {
+    func listTrashed() throws -> [Project] {
+        let descriptor = FetchDescriptor<Project>(
+            predicate: #Predicate { $0.deletedAt != nil },
+            sortBy: [SortDescriptor(\.deletedAt, order: .reverse)]
+        )
+        return try context.fetch(descriptor)
+    }
+
+    /// Whether a live project other than `excluded` already uses `name`, ignoring case.
+    private func nameExists(_ name: String, excluding excluded: Project? = nil) throws -> Bool {
         let lowered = name.lowercased()
-        let descriptor = FetchDescriptor<Project>()
+        let descriptor = FetchDescriptor<Project>(
+            predicate: #Predicate { $0.deletedAt == nil }
+        )
         let all = try context.fetch(descriptor)
-        return all.contains { $0.name.lowercased() == lowered }
+        return all.contains { $0.id != excluded?.id && $0.name.lowercased() == lowered }
     }
 
     private func nextSortOrder() throws -> Int {
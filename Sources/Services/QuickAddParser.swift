@@ -0,0 +1,149 @@
+import Foundation
+
+struct QuickAddResult: Equatable {
+    var title: String
+    var projectName: String?
+    var tagNames: [String] = []
+    var priority: Priority?
+    var dueDate: Date?
+
+    var hasMetadata: Bool {
+        projectName != nil || !tagNames.isEmpty || priority != nil || dueDate != nil
+    }
+}
+
+/// Parses one-line quick-add input such as
+/// `Fix login bug #backend @urgent !p1 due:fri` into todo fields.
+/// Multi-word due phrases are joined with dashes (`due:next-fri`).
+/// Tokens that don't parse are kept as part of the title.
+enum QuickAddParser {
+    static func parse(_ input: String, now: Date = Date()) -> QuickAddResult {
+        var result = QuickAddResult(title: "")
+        var titleWords: [String] = []
+
+        for token in input.split(whereSeparator: \.isWhitespace).map(String.init) {
+            if token.count > 1, token.hasPrefix("#") {
+                result.projectName = String(token.dropFirst())
+            } else if token.count > 1, token.hasPrefix("@") {
+                let name = String(token.dropFirst())
+                if !result.tagNames.contains(where: { $0.caseInsensitiveCompare(name) == .orderedSame }) {
+                    result.tagNames.append(name)
+                }
+            } else if token.hasPrefix("!"), let priority = parsePriority(token.dropFirst()) {
+                result.priority = priority
+            } else if token.lowercased().hasPrefix("due:"),
+                      let date = parseDueDate(String(token.dropFirst(4)), now: now) {
+                result.dueDate = date
+            } else {
+                titleWords.append(token)
+            }
+        }
+
+        result.title = titleWords.joined(separator: " ")
+        if result.title.isEmpty {
+            result.title = input.trimmingCharacters(in: .whitespacesAndNewlines)
+        }
+        return result
+    }
+
+    /// Accepts `today`, `tomorrow`, weekday names (next occurrence), `next fri`,
+    /// `next week`/`next month`, `in 3 days`/`in 2 weeks`, `end of week`/`end of
+    /// month`, `+3d`/`+2w` and ISO `yyyy-MM-dd`. Words may be separated by
+    /// spaces, dashes or underscores. Dates resolve to the end of the day so a
+    /// todo due today isn't shown as overdue.
+    static func parseDueDate(_ value: String, now: Date = Date()) -> Date? {
+        let calendar = Calendar.current
+        let words = value.lowercased()
+            .split { $0.isWhitespace || $0 == "-" || $0 == "_" }
+            .map(String.init)
+        let text = words.joined(separator: " ")
+        let today = calendar.startOfDay(for: now)
+
+        var day: Date?
+        switch text {
+        case "today", "tod":
+            day = today
+        case "tomorrow", "tom":
+            day = calendar.date(byAdding: .day, value: 1, to: today)
+        case "next week":
+            day = calendar.date(byAdding: .day, value: 7, to: today)
+        case "next month":
+            day = calendar.date(byAdding: .month, value: 1, to: today)
+        case "end of week", "eow":
+            // The week's last day per the user's calendar (Saturday or Sunday)
+            day = calendar.dateInterval(of: .weekOfYear, for: today)
+                .flatMap { calendar.date(byAdding: .day, value: -1, to: $0.end) }
+        case "end of month", "eom":
+            day = calendar.dateInterval(of: .month, for: today)
+                .flatMap { calendar.date(byAdding: .day, value: -1, to: $0.end) }
+        default:
+            if words.count == 2, words[0] == "next", let weekday = weekdayIndex(words[1]) {
+                // "next fri" skips this week's Friday when today is Mon–Thu
+                let upcoming = calendar.nextDate(
+                    after: today,
+                    matching: DateComponents(weekday: weekday),
+                    matchingPolicy: .nextTime
+                )
+                let weekEnd = calendar.dateInterval(of: .weekOfYear, for: today)?.end
+                if let upcoming, let weekEnd, upcoming < weekEnd {
+                    day = calendar.date(byAdding: .day, value: 7, to: upcoming)
+                } else {
+                    day = upcoming
+                }
+            } else if words.count == 3, words[0] == "in", let amount = Int(words[1]) {
+                switch words[2] {
+                case "day", "days": day = calendar.date(byAdding: .day, value: amount, to: today)
+                case "week", "weeks": day = calendar.date(byAdding: .day, value: amount * 7, to: today)
+                case "month", "months": day = calendar.date(byAdding: .month, value: amount, to: today)
+                default: break
+                }
+            } else if let weekday = weekdayIndex(text) {
+                day = calendar.nextDate(
+                    after: today,
+                    matching: DateComponents(weekday: weekday),
+                    matchingPolicy: .nextTime
+                )
+            } else if text.hasPrefix("+"), let offset = Int(text.dropFirst().dropLast()) {
+                switch text.last {
+                case "d": day = calendar.date(byAdding: .day, value: offset, to: today)
+                case "w": day = calendar.date(byAdding: .day, value: offset * 7, to: today)
+                default: break
+                }
+            } else if let date = isoDay.date(from: value.trimmingCharacters(in: .whitespaces)) {
+                day = date
+            }
+        }
+
+        return day.flatMap {
+            calendar.date(bySettingHour: 23, minute: 59, second: 0, of: $0)
+        }
+    }
+
+    // MARK: - Private
+
+    private static let isoDay: DateFormatter = {
+        let formatter = DateFormatter()
+        formatter.locale = Locale(identifier: "en_US_POSIX")
+        formatter.dateFormat = "yyyy-MM-dd"
+        return formatter
+    }()
+
+    private static func parsePriority(_ value: Substring) -> Priority? {
+        switch value.lowercased() {
+        case "p1", "1", "high": .high
+        case "p2", "2", "medium", "med": .medium
+        case "p3", "3", "low": .low
+        default: nil
+        }
+    }
+
+    /// Calendar weekday (1 = Sunday) for full or three-letter English names.
+    private static func weekdayIndex(_ text: String) -> Int? {
+        let names = ["sun", "mon", "tue", "wed", "thu", "fri", "sat"]
+        guard text.count >= 3,
+              let index = names.firstIndex(of: String(text.prefix(3))) else { return nil }
+        let full = ["sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"]
+        guard full[index].hasPrefix(text) else { return nil }
+        return index + 1
+    }
+}
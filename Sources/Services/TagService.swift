@@ -14,6 +14,7 @@ struct TagService: TagServiceProtocol {
         guard try !nameExists(trimmed) else {
             throw ValidationError.duplicateName(trimmed)
         }
+        guard color.isValidHexColor else { throw ValidationError.invalidColor(color) }
 
         let tag = Tag(
             name: trimmed,
@@ -24,6 +25,9 @@ struct TagService: TagServiceProtocol {
     }
 
     func update(_ tag: Tag, name: String? = nil, color: String? = nil) throws {
+        if let color, !color.isValidHexColor {
+            throw ValidationError.invalidColor(color)
+        }
         if let name {
             let trimmed = name.trimmingCharacters(in: .whitespacesAndNewlines)
             let exists = trimmed == tag.name ? false : try nameExists(trimmed)
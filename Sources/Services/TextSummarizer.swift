@@ -0,0 +1,59 @@
+import Foundation
+import NaturalLanguage
+
+enum TextSummarizer {
+    /// Texts shorter than this are shown as-is without a summary.
+    static let minimumLength = 800
+
+    private static let stopWords: Set<String> = [
+        "a", "an", "and", "are", "as", "at", "be", "but", "by", "for",
+        "from", "has", "have", "he", "her", "his", "i", "if", "in", "is",
+        "it", "its", "me", "my", "not", "of", "on", "or", "our", "she",
+        "so", "that", "the", "their", "them", "there", "they", "this",
+        "to", "was", "we", "were", "what", "when", "which", "will",
+        "with", "would", "you", "your",
+    ]
+
+    /// Picks the `maxSentences` highest-scoring sentences by normalized word
+    /// frequency and returns them in their original order.
+    static func summarize(_ text: String, maxSentences: Int = 3) -> [String] {
+        guard text.count >= minimumLength else { return [] }
+
+        let sentences = split(text, unit: .sentence)
+            .map { $0.trimmingCharacters(in: .whitespacesAndNewlines) }
+            .filter { !$0.isEmpty }
+        guard sentences.count > maxSentences else { return [] }
+
+        var frequencies: [String: Int] = [:]
+        for word in split(text, unit: .word) {
+            let key = word.lowercased()
+            guard key.count > 2, !stopWords.contains(key) else { continue }
+            frequencies[key, default: 0] += 1
+        }
+        guard let maxFrequency = frequencies.values.max() else { return [] }
+
+        let scored = sentences.enumerated().map { index, sentence in
+            let words = split(sentence, unit: .word)
+            let score = words.reduce(0.0) { total, word in
+                let weight = frequencies[word.lowercased()] ?? 0
+                return total + Double(weight) / Double(maxFrequency)
+            }
+            // Normalize so long sentences don't always win
+            return (index: index, score: score / Double(max(words.count, 1)).squareRoot())
+        }
+
+        return scored
+            .sorted { $0.score > $1.score }
+            .prefix(maxSentences)
+            .sorted { $0.index < $1.index }
+            .map { sentences[$0.index] }
+    }
+
+    private static func split(_ text: String, unit: NLTokenUnit) -> [String] {
+        let tokenizer = NLTokenizer(unit: unit)
+        tokenizer.string = text
+        return tokenizer.tokens(for: text.startIndex..<text.endIndex).map {
+            String(text[$0])
+        }
+    }
+}
@@ -0,0 +1,68 @@
+import Foundation
+
+/// Renders a todo's details as a standalone Markdown document.
+enum TodoMarkdownExporter {
+    static func markdown(for todo: Todo) -> String {
+        var lines = ["# \(todo.title)", ""]
+
+        var metadata: [(String, String)] = [
+            ("Status", status(of: todo)),
+            ("Priority", todo.priority.label),
+        ]
+        if let project = todo.project {
+            metadata.append(("Project", project.name))
+        }
+        if !todo.tags.isEmpty {
+            metadata.append(("Tags", todo.tags.map(\.name).sorted().joined(separator: ", ")))
+        }
+        if let startDate = todo.startDate {
+            metadata.append(("Start", Formatters.mediumDate.string(from: startDate)))
+        }
+        if let dueDate = todo.dueDate {
+            metadata.append(("Due", Formatters.mediumDate.string(from: dueDate)))
+        }
+        if let ticketID = todo.jiraLink?.ticketID {
+            let link = todo.browseURL.map { "[\(ticketID)](\($0.absoluteString))" } ?? ticketID
+            metadata.append(("Jira", link))
+        }
+        if let pr = todo.bitbucketLink {
+            let name = "\(pr.repositorySlug)#\(pr.prNumber)"
+            let link = pr.browseURL.map { "[\(name)](\($0.absoluteString))" } ?? name
+            metadata.append(("Pull Request", link))
+        }
+        metadata.append(("Created", Formatters.dateTime.string(from: todo.createdAt)))
+
+        lines += metadata.map { "- **\($0.0):** \($0.1)" }
+
+        let tracked = todo.timeEntries.reduce(0.0) { $0 + $1.duration }
+        if tracked > 0 {
+            lines.append("- **Time tracked:** \(tracked.hoursMinutes)")
+        }
+
+        let notes = todo.descriptionText.trimmingCharacters(in: .whitespacesAndNewlines)
+        if !notes.isEmpty {
+            lines += ["", "## Notes", "", notes]
+        }
+
+        return lines.joined(separator: "\n") + "\n"
+    }
+
+    /// File name derived from the title, e.g. `fix-login-bug.md`.
+    static func suggestedFileName(for todo: Todo) -> String {
+        let slug = todo.title
+            .lowercased()
+            .components(separatedBy: CharacterSet.alphanumerics.inverted)
+            .filter { !$0.isEmpty }
+            .prefix(8)
+            .joined(separator: "-")
+        return (slug.isEmpty ? "todo" : slug) + ".md"
+    }
+
+    private static func status(of todo: Todo) -> String {
+        if todo.isTrashed { return "Trashed" }
+        if todo.isArchived { return "Archived" }
+        if todo.isCompleted { return "Completed" }
+        if todo.isSnoozed { return "Snoozed" }
+        return todo.isReference ? "Reference" : "Open"
+    }
+}
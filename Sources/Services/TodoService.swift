@@ -30,14 +30,17 @@ struct TodoService: TodoServiceProtocol {
     }
 
     func update(_ todo: Todo, title: String? = nil, descriptionText: String? = nil,
-                priority: Priority? = nil, dueDate: Date?? = nil,
-                project: Project?? = nil, tags: [Tag]? = nil) {
+                priority: Priority? = nil, startDate: Date?? = nil, dueDate: Date?? = nil,
+                project: Project?? = nil, tags: [Tag]? = nil,
+                reminderLeadDays: [Int]?? = nil) {
         if let title { todo.title = title }
         if let descriptionText { todo.descriptionText = descriptionText }
         if let priority { todo.priority = priority }
+        if let startDate { todo.startDate = startDate }
         if let dueDate { todo.dueDate = dueDate }
         if let project { todo.project = project }
         if let tags { todo.tags = tags }
+        if let reminderLeadDays { todo.reminderLeadDays = reminderLeadDays }
         todo.updatedAt = Date()
     }
 
@@ -68,9 +71,108 @@ struct TodoService: TodoServiceProtocol {
 
     func restore(_ todo: Todo) {
         todo.deletedAt = nil
+        // A project still in the trash would keep the todo out of sight
+        if todo.project?.isTrashed == true {
+            todo.project = nil
+        }
+        todo.updatedAt = Date()
+    }
+
+    func permanentlyDelete(_ todo: Todo) {
+        context.delete(todo)
+    }
+
+    func setKind(_ todo: Todo, kind: TodoKind) {
+        todo.kind = kind
+        if kind == .reference {
+            todo.startDate = nil
+            todo.dueDate = nil
+        }
+        todo.updatedAt = Date()
+    }
+
+    func addBlocker(_ blocker: Todo, to todo: Todo) throws {
+        guard !todo.blockers.contains(where: { $0.id == blocker.id }) else { return }
+        if blocker.id == todo.id || Self.isBlocked(blocker, by: todo) {
+            throw ValidationError.dependencyCycle
+        }
+        todo.blockers.append(blocker)
+        todo.updatedAt = Date()
+    }
+
+    func removeBlocker(_ blocker: Todo, from todo: Todo) {
+        todo.blockers.removeAll { $0.id == blocker.id }
+        todo.updatedAt = Date()
+    }
+
+    /// Whether `todo` transitively waits on `candidate`.
+    static func isBlocked(_ todo: Todo, by candidate: Todo) -> Bool {
+        var visited: Set<UUID> = []
+        var stack = todo.blockers
+        while let next = stack.popLast() {
+            if next.id == candidate.id { return true }
+            if visited.insert(next.id).inserted {
+                stack.append(contentsOf: next.blockers)
+            }
+        }
+        return false
+    }
+
+    func linkJira(_ todo: Todo, ticketID: String, serverURL: String) {
+        if let existing = todo.jiraLink {
+            context.delete(existing)
+        }
+        let link = JiraLink(ticketID: ticketID, serverURL: serverURL, todo: todo)
+        context.insert(link)
+        todo.jiraLink = link
+        todo.updatedAt = Date()
+    }
+
+    func snooze(_ todo: Todo, until date: Date?) {
+        todo.snoozedUntil = date
+        todo.updatedAt = Date()
+    }
+
+    func archive(_ todo: Todo) {
+        todo.archivedAt = Date()
+        todo.updatedAt = Date()
+    }
+
+    func unarchive(_ todo: Todo) {
+        todo.archivedAt = nil
         todo.updatedAt = Date()
     }
 
+    func archiveCompleted(olderThanDays days: Int) throws -> Int {
+        let cutoff = Calendar.current.date(byAdding: .day, value: -days, to: Date())!
+        let descriptor = FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.isCompleted == true && todo.archivedAt == nil && todo.deletedAt == nil
+            }
+        )
+        let stale = try context.fetch(descriptor).filter {
+            ($0.completedAt ?? $0.updatedAt) < cutoff
+        }
+        for todo in stale {
+            archive(todo)
+        }
+        return stale.count
+    }
+
+    func purgeArchived(retentionDays: Int) throws -> Int {
+        let cutoff = Calendar.current.date(byAdding: .day, value: -retentionDays, to: Date())!
+        let descriptor = FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.archivedAt != nil && todo.archivedAt! < cutoff
+            }
+        )
+        let expired = try context.fetch(descriptor)
+        for todo in expired {
+            context.delete(todo)
+        }
+        return expired.count
+    }
+
     func purgeExpired() throws -> Int {
         let cutoff = Calendar.current.date(byAdding: .day, value: -AppConfig.todoPurgeDays, to: Date())!
         let descriptor = FetchDescriptor<Todo>(
@@ -91,8 +193,11 @@ struct TodoService: TodoServiceProtocol {
         tag: Tag? = nil,
         priority: Priority? = nil,
         isCompleted: Bool? = nil,
+        kind: TodoKind? = nil,
+        isSnoozed: Bool? = nil,
         searchText: String = "",
-        includeTrashed: Bool = false
+        includeTrashed: Bool = false,
+        includeArchived: Bool = false
     ) throws -> [Todo] {
         var descriptor = FetchDescriptor<Todo>(
             sortBy: [
@@ -105,6 +210,7 @@ struct TodoService: TodoServiceProtocol {
 
         descriptor.predicate = #Predicate<Todo> { todo in
             (includeTrashed || todo.deletedAt == nil)
+                && (includeArchived || todo.archivedAt == nil)
         }
 
         var results = try context.fetch(descriptor)
@@ -129,6 +235,14 @@ struct TodoService: TodoServiceProtocol {
             results = results.filter { $0.isCompleted == isCompleted }
         }
 
+        if let kind {
+            results = results.filter { $0.kind == kind }
+        }
+
+        if let isSnoozed {
+            results = results.filter { $0.isSnoozed == isSnoozed }
+        }
+
         if !trimmedSearch.isEmpty {
             results = results.filter { todo in
                 todo.title.lowercased().contains(trimmedSearch)
@@ -147,6 +261,14 @@ struct TodoService: TodoServiceProtocol {
         return try context.fetch(descriptor)
     }
 
+    func listArchived() throws -> [Todo] {
+        let descriptor = FetchDescriptor<Todo>(
+            predicate: #Predicate { $0.archivedAt != nil && $0.deletedAt == nil },
+            sortBy: [SortDescriptor(\.archivedAt, order: .reverse)]
+        )
+        return try context.fetch(descriptor)
+    }
+
     func reorder(_ todo: Todo, newSortOrder: Int) {
         todo.sortOrder = newSortOrder
         todo.updatedAt = Date()
@@ -29,6 +29,12 @@ final class TrackingCoordinator {
         }
     }
 
+    /// Stops all plugins so in-progress entries are finalized before the app exits.
+    func shutdown() async {
+        await pluginManager?.stopAll()
+        logService?.log("Tracking stopped for shutdown")
+    }
+
     func syncPlugins() async {
         await pluginManager?.syncAll()
     }
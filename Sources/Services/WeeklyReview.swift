@@ -0,0 +1,157 @@
+import Foundation
+import SwiftData
+
+/// What happened in one calendar week: todos finished and added, work in
+/// progress, overdue and upcoming due dates, blocked todos, and tracked time
+/// per project.
+struct WeeklyReview {
+    let interval: DateInterval
+    let completed: [Todo]
+    let created: [Todo]
+    let inProgress: [Todo]
+    let overdue: [Todo]
+    let upcoming: [Todo]
+    let blocked: [Todo]
+    let timeByProject: [(project: String, duration: TimeInterval)]
+
+    // Finished todos tied to a pull request; the PR's own review state isn't tracked
+    var completedWithPullRequests: [Todo] { completed.filter { $0.bitbucketLink != nil } }
+    var totalTime: TimeInterval { timeByProject.reduce(0) { $0 + $1.duration } }
+
+    static func build(
+        weekContaining date: Date, context: ModelContext, now: Date = Date()
+    ) throws -> WeeklyReview {
+        let calendar = Calendar.current
+        let interval = calendar.dateInterval(of: .weekOfYear, for: date)
+            ?? DateInterval(start: date, duration: 7 * 86_400)
+        let start = interval.start
+        let end = interval.end
+        // Overdue means due before today, as in the list; for past weeks, before the week ended
+        let overdueCutoff = min(end, calendar.startOfDay(for: now))
+
+        let completed = try context.fetch(FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.deletedAt == nil && todo.isCompleted == true
+                    && todo.completedAt != nil
+                    && todo.completedAt! >= start && todo.completedAt! < end
+            },
+            sortBy: [SortDescriptor(\.completedAt)]
+        ))
+        let created = try context.fetch(FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.deletedAt == nil && todo.createdAt >= start && todo.createdAt < end
+            },
+            sortBy: [SortDescriptor(\.createdAt)]
+        ))
+        let overdue = try context.fetch(FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.deletedAt == nil && todo.isCompleted == false
+                    && todo.dueDate != nil && todo.dueDate! < overdueCutoff
+            },
+            sortBy: [SortDescriptor(\.dueDate)]
+        )).filter { !$0.isReference }
+        // Due between the overdue cutoff and the end of the following week
+        let upcomingEnd = calendar.date(byAdding: .weekOfYear, value: 1, to: end) ?? end
+        let upcoming = try context.fetch(FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.deletedAt == nil && todo.isCompleted == false
+                    && todo.dueDate != nil
+                    && todo.dueDate! >= overdueCutoff && todo.dueDate! < upcomingEnd
+            },
+            sortBy: [SortDescriptor(\.dueDate)]
+        )).filter { !$0.isReference && !$0.isArchived }
+        let blocked = try context.fetch(FetchDescriptor<Todo>(
+            predicate: #Predicate { todo in
+                todo.deletedAt == nil && todo.isCompleted == false
+            },
+            sortBy: [SortDescriptor(\.title)]
+        )).filter { $0.isBlocked && !$0.isArchived }
+
+        let entries = try context.fetch(FetchDescriptor<TimeEntry>(
+            predicate: #Predicate { entry in
+                entry.isExcluded == false && entry.startTime >= start && entry.startTime < end
+            }
+        ))
+        // Open todos that had time tracked against them during the week
+        var inProgressByID: [UUID: Todo] = [:]
+        for todo in entries.compactMap(\.todo) where todo.isActive && !todo.isArchived {
+            inProgressByID[todo.id] = todo
+        }
+        let inProgress = inProgressByID.values.sorted { $0.title < $1.title }
+
+        let grouped = Dictionary(grouping: entries) { $0.todo?.project?.name ?? "No project" }
+        let timeByProject = grouped
+            .map { (project: $0.key, duration: $0.value.reduce(0) { $0 + $1.effectiveDuration }) }
+            .filter { $0.duration > 0 }
+            .sorted { $0.duration > $1.duration }
+
+        return WeeklyReview(
+            interval: interval,
+            completed: completed,
+            created: created,
+            inProgress: inProgress,
+            overdue: overdue,
+            upcoming: upcoming,
+            blocked: blocked,
+            timeByProject: timeByProject
+        )
+    }
+
+    var title: String {
+        let last = interval.end.addingTimeInterval(-1)
+        return "Week of \(Formatters.mediumDate.string(from: interval.start)) – "
+            + Formatters.mediumDate.string(from: last)
+    }
+
+    var markdown: String {
+        var lines = ["# \(title)", ""]
+
+        func section(_ heading: String, _ items: [String]) {
+            lines.append("## \(heading) (\(items.count))")
+            lines.append("")
+            lines += items.isEmpty ? ["_None_"] : items.map { "- \($0)" }
+            lines.append("")
+        }
+
+        section("Completed", completed.map(Self.line))
+        section("Created", created.map(Self.line))
+        section("In Progress", inProgress.map(Self.line))
+        section("Overdue", overdue.map(Self.lineWithDueDate))
+        section("Upcoming", upcoming.map(Self.lineWithDueDate))
+        section("Blocked", blocked.map { todo in
+            Self.line(todo) + " (waiting on \(todo.openBlockers.map(\.title).joined(separator: ", ")))"
+        })
+        section("Completed with Pull Requests", completedWithPullRequests.compactMap { todo in
+            todo.bitbucketLink.map { pr in
+                let name = "\(pr.repositorySlug)#\(pr.prNumber)"
+                let link = pr.browseURL.map { "[\(name)](\($0.absoluteString))" } ?? name
+                return "\(link) — \(todo.title)"
+            }
+        })
+
+        lines.append("## Time (\(totalTime.hoursMinutes))")
+        lines.append("")
+        if timeByProject.isEmpty {
+            lines.append("_None_")
+        } else {
+            lines += timeByProject.map { "- \($0.project): \($0.duration.hoursMinutes)" }
+        }
+        return lines.joined(separator: "\n") + "\n"
+    }
+
+    private static func lineWithDueDate(_ todo: Todo) -> String {
+        let due = todo.dueDate.map { " (due \(Formatters.mediumDate.string(from: $0)))" } ?? ""
+        return line(todo) + due
+    }
+
+    private static func line(_ todo: Todo) -> String {
+        var text = todo.title
+        if let ticketID = todo.jiraLink?.ticketID {
+            text += " [\(ticketID)]"
+        }
+        if let project = todo.project {
+            text += " — \(project.name)"
+        }
+        return text
+    }
+}
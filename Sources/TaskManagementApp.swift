@@ -4,31 +4,50 @@ import SwiftData
 
 @main
 struct TaskManagementApp: App {
+    @NSApplicationDelegateAdaptor(AppDelegate.self) private var appDelegate
+
     let modelContainer: ModelContainer
 
     @State private var coordinator: TrackingCoordinator
     @State private var pluginManager: PluginManager
     @State private var logService: LogService
     @State private var serviceContainer: LiveServiceContainer
+    @State private var dueDateNotifier: DueDateNotificationService
+    @State private var pomodoro: PomodoroService
+
+    static let schema = Schema([
+        Todo.self,
+        Project.self,
+        Tag.self,
+        JiraLink.self,
+        BitbucketLink.self,
+        TimeEntry.self,
+        IntegrationConfig.self,
+        TicketOverride.self,
+        ExportRecord.self,
+        LearnedPattern.self,
+    ])
 
     init() {
         do {
-            let schema = Schema([
-                Todo.self,
-                Project.self,
-                Tag.self,
-                JiraLink.self,
-                BitbucketLink.self,
-                TimeEntry.self,
-                IntegrationConfig.self,
-                TicketOverride.self,
-                ExportRecord.self,
-                LearnedPattern.self,
-            ])
-            let config = ModelConfiguration(isStoredInMemoryOnly: false)
-            let container = try ModelContainer(for: schema, configurations: config)
-            modelContainer = container
+            let isDemo = AppConfig.isDemoMode
+            let config = ModelConfiguration(isStoredInMemoryOnly: isDemo)
             let log = LogService()
+            if !isDemo {
+                do {
+                    if try BackupService(storeURL: config.url).applyPendingRestore() {
+                        log.log("Restored data store from backup")
+                    }
+                } catch {
+                    log.log("Restoring backup failed, keeping current data: \(error)", level: .error)
+                }
+            }
+            let container = try ModelContainer(for: Self.schema, configurations: config)
+            modelContainer = container
+            if isDemo {
+                try DemoData.seed(into: container.mainContext)
+                log.log("Running in demo mode with sample data")
+            }
             _logService = State(initialValue: log)
             _coordinator = State(
                 initialValue: TrackingCoordinator(modelContainer: container, logService: log)
@@ -37,6 +56,12 @@ struct TaskManagementApp: App {
             _serviceContainer = State(
                 initialValue: LiveServiceContainer(modelContainer: container, logService: log)
             )
+            _dueDateNotifier = State(
+                initialValue: DueDateNotificationService(modelContainer: container, logService: log)
+            )
+            _pomodoro = State(
+                initialValue: PomodoroService(modelContainer: container, logService: log)
+            )
         } catch {
             fatalError("Failed to create ModelContainer: \(error)")
         }
@@ -46,16 +71,31 @@ struct TaskManagementApp: App {
         WindowGroup(id: "main") {
             ContentView()
                 .environment(coordinator)
+                .environment(pomodoro)
                 .environment(\.serviceContainer, serviceContainer)
                 .environment(\.logService, logService)
+                .defaultAppStorage(AppConfig.defaults)
                 .onAppear {
                     NSApp.setActivationPolicy(.regular)
                     NSApp.activate(ignoringOtherApps: true)
                     NSApp.windows.first?.makeKeyAndOrderFront(nil)
-                    setupPlugins()
+                    // Demo data stays inside the app: no activity tracking,
+                    // desktop notifications or backups
+                    if !AppConfig.isDemoMode {
+                        setupPlugins()
+                    }
                     purgeExpiredData()
+                    runAutomaticBackup()
                     coordinator.recoverFromCrash()
-                    coordinator.startTracking()
+                    if !AppConfig.isDemoMode {
+                        coordinator.startTracking()
+                        dueDateNotifier.start()
+                    }
+                    appDelegate.onTerminate = { [coordinator, dueDateNotifier, pomodoro] in
+                        dueDateNotifier.stop()
+                        pomodoro.stop()
+                        await coordinator.shutdown()
+                    }
                 }
         }
         .modelContainer(modelContainer)
@@ -65,6 +105,7 @@ struct TaskManagementApp: App {
                 .environment(coordinator)
                 .environment(\.serviceContainer, serviceContainer)
                 .environment(\.logService, logService)
+                .defaultAppStorage(AppConfig.defaults)
         }
 
         MenuBarExtra("Task Management", systemImage: "checklist.checked") {
@@ -81,6 +122,14 @@ struct TaskManagementApp: App {
             }
             .keyboardShortcut("o", modifiers: [.command])
 
+            if let phase = pomodoro.phase, let endsAt = pomodoro.endsAt {
+                Divider()
+                Text("\(phase.label) until \(Formatters.shortTime.string(from: endsAt))")
+                Button("Stop Pomodoro") {
+                    pomodoro.stop()
+                }
+            }
+
             Divider()
 
             SettingsLink {
@@ -115,6 +164,22 @@ struct TaskManagementApp: App {
         coordinator.setPluginManager(pluginManager)
     }
 
+    private func runAutomaticBackup() {
+        guard AppConfig.autoBackupEnabled, !AppConfig.isDemoMode,
+              let storeURL = modelContainer.configurations.first?.url else { return }
+        let service = BackupService(storeURL: storeURL)
+        let keep = AppConfig.backupKeepCount
+        Task.detached(priority: .background) { [logService] in
+            do {
+                if let url = try service.backupIfDue(keep: keep) {
+                    await logService.log("Created daily backup \(url.lastPathComponent)")
+                }
+            } catch {
+                await logService.log("Daily backup failed: \(error)", level: .error)
+            }
+        }
+    }
+
     private func purgeExpiredData() {
         let service = serviceContainer.makeTimeEntryService()
         Task {
@@ -122,5 +187,54 @@ struct TaskManagementApp: App {
                 logService.log("Purged \(count) expired records")
             }
         }
+
+        let context = modelContainer.mainContext
+        let todoService = serviceContainer.makeTodoService(context: context)
+        let projectService = serviceContainer.makeProjectService(context: context)
+        do {
+            let archived = try todoService.purgeArchived(retentionDays: AppConfig.archiveRetentionDays)
+            let trashedTodos = try todoService.purgeExpired()
+            let trashedProjects = try projectService.purgeExpired()
+            if archived + trashedTodos + trashedProjects > 0 {
+                try context.save()
+                logService.log(
+                    "Purged \(archived) archived todos, \(trashedTodos) trashed todos"
+                        + " and \(trashedProjects) trashed projects"
+                )
+            }
+        } catch {
+            logService.log("Todo purge failed: \(error)", level: .error)
+        }
+    }
+}
+
+/// Defers termination until in-progress tracking has been written, bounded by
+/// `AppConfig.shutdownTimeout` so a stuck plugin can't block quitting.
+@MainActor
+final class AppDelegate: NSObject, NSApplicationDelegate {
+    var onTerminate: (() async -> Void)?
+    private var isTerminating = false
+    private var hasReplied = false
+
+    func applicationShouldTerminate(_ sender: NSApplication) -> NSApplication.TerminateReply {
+        guard let onTerminate else { return .terminateNow }
+        guard !isTerminating else { return .terminateLater }
+        isTerminating = true
+
+        Task {
+            await onTerminate()
+            reply(to: sender)
+        }
+        Task {
+            try? await Task.sleep(for: .seconds(AppConfig.shutdownTimeout))
+            reply(to: sender)
+        }
+        return .terminateLater
+    }
+
+    private func reply(to sender: NSApplication) {
+        guard !hasReplied else { return }
+        hasReplied = true
+        sender.reply(toApplicationShouldTerminate: true)
     }
 }
@@ -10,9 +10,30 @@ enum AppConfig {
         static let wakatimeSyncInterval = "wakatimeSyncInterval"
         static let dataRetentionDays = "dataRetentionDays"
         static let todoPurgeDays = "todoPurgeDays"
+        static let worklogRoundingMinutes = "worklogRoundingMinutes"
+        static let pomodoroFocusMinutes = "pomodoroFocusMinutes"
+        static let pomodoroBreakMinutes = "pomodoroBreakMinutes"
+        static let reminderLeadDays = "reminderLeadDays"
+        static let landingView = "landingView"
+        static let todoRowFields = "todoRowFields"
+        static let todoGrouping = "todoGrouping"
+        static let todoSort = "todoSort"
+        static let lastNavigationItem = "lastNavigationItem"
+        static let lastSelectedTodoID = "lastSelectedTodoID"
+        static let showLogPanel = "showLogPanel"
+        static let archiveAfterDays = "archiveAfterDays"
+        static let archiveRetentionDays = "archiveRetentionDays"
+        static let autoBackupEnabled = "autoBackupEnabled"
+        static let backupKeepCount = "backupKeepCount"
         static let bitbucketCacheTTL = "bitbucketCacheTTL"
         static let jiraCacheTTL = "jiraCacheTTL"
+        static let jiraPromoteProjectKey = "jiraPromoteProjectKey"
+        static let jiraPromoteIssueType = "jiraPromoteIssueType"
+        static let jiraPromoteSendsPriority = "jiraPromoteSendsPriority"
+        static let jiraWritesAllowed = "jiraWritesAllowed"
         static let maxLogEntries = "maxLogEntries"
+        static let dueCheckInterval = "dueCheckInterval"
+        static let shutdownTimeout = "shutdownTimeout"
     }
 
     enum Defaults {
@@ -24,52 +45,164 @@ enum AppConfig {
         static let wakatimeSyncInterval: Double = 300
         static let dataRetentionDays: Double = 90
         static let todoPurgeDays: Double = 30
+        static let worklogRoundingMinutes: Double = 15
+        static let pomodoroFocusMinutes: Double = 25
+        static let pomodoroBreakMinutes: Double = 5
+        static let reminderLeadDays = "1"
+        static let landingView = "timeTracking"
+        static let todoRowFields = "priority,project,tags,dueDate"
+        static let archiveAfterDays: Double = 30
+        static let archiveRetentionDays: Double = 365
+        static let autoBackupEnabled = true
+        static let backupKeepCount: Double = 7
         static let bitbucketCacheTTL: Double = 86_400
         static let jiraCacheTTL: Double = 300
         static let maxLogEntries: Int = 200
+        static let dueCheckInterval: Double = 300
+        static let shutdownTimeout: Double = 5
     }
 
     // MARK: - User-Configurable (exposed in Settings UI)
 
     static var browserPollInterval: TimeInterval {
-        let val = UserDefaults.standard.double(forKey: Keys.browserPollInterval)
+        let val = defaults.double(forKey: Keys.browserPollInterval)
         return val > 0 ? val : Defaults.browserPollInterval
     }
 
     static var browserMinDuration: TimeInterval {
-        let val = UserDefaults.standard.double(forKey: Keys.browserMinDuration)
+        let val = defaults.double(forKey: Keys.browserMinDuration)
         return val > 0 ? val : Defaults.browserMinDuration
     }
 
     static var wakatimeSyncInterval: TimeInterval {
-        let val = UserDefaults.standard.double(forKey: Keys.wakatimeSyncInterval)
+        let val = defaults.double(forKey: Keys.wakatimeSyncInterval)
         return val > 0 ? val : Defaults.wakatimeSyncInterval
     }
 
     static var dataRetentionDays: Int {
-        let val = UserDefaults.standard.double(forKey: Keys.dataRetentionDays)
+        let val = defaults.double(forKey: Keys.dataRetentionDays)
         return val > 0 ? Int(val) : Int(Defaults.dataRetentionDays)
     }
 
     static var todoPurgeDays: Int {
-        let val = UserDefaults.standard.double(forKey: Keys.todoPurgeDays)
+        let val = defaults.double(forKey: Keys.todoPurgeDays)
         return val > 0 ? Int(val) : Int(Defaults.todoPurgeDays)
     }
 
+    static var worklogRoundingMinutes: Int {
+        let val = defaults.double(forKey: Keys.worklogRoundingMinutes)
+        return val > 0 ? Int(val) : Int(Defaults.worklogRoundingMinutes)
+    }
+
+    static var pomodoroFocusMinutes: Double {
+        let val = defaults.double(forKey: Keys.pomodoroFocusMinutes)
+        return val > 0 ? val : Defaults.pomodoroFocusMinutes
+    }
+
+    static var pomodoroBreakMinutes: Double {
+        let val = defaults.double(forKey: Keys.pomodoroBreakMinutes)
+        return val > 0 ? val : Defaults.pomodoroBreakMinutes
+    }
+
+    static var archiveAfterDays: Int {
+        let val = defaults.double(forKey: Keys.archiveAfterDays)
+        return val > 0 ? Int(val) : Int(Defaults.archiveAfterDays)
+    }
+
+    static var archiveRetentionDays: Int {
+        let val = defaults.double(forKey: Keys.archiveRetentionDays)
+        return val > 0 ? Int(val) : Int(Defaults.archiveRetentionDays)
+    }
+
+    static var autoBackupEnabled: Bool {
+        defaults.object(forKey: Keys.autoBackupEnabled) as? Bool
+            ?? Defaults.autoBackupEnabled
+    }
+
+    /// Set once the user chooses "Always Allow" on the Jira write prompt.
+    static var jiraWritesAllowed: Bool {
+        defaults.bool(forKey: Keys.jiraWritesAllowed)
+    }
+
+    static var backupKeepCount: Int {
+        let val = defaults.double(forKey: Keys.backupKeepCount)
+        return val > 0 ? Int(val) : Int(Defaults.backupKeepCount)
+    }
+
+    /// Startup view; a `--view <name>` launch argument takes precedence over the setting.
+    static var landingView: LandingView {
+        let arguments = CommandLine.arguments
+        if let index = arguments.firstIndex(of: "--view"),
+           index + 1 < arguments.count,
+           let view = LandingView(rawValue: arguments[index + 1]) {
+            return view
+        }
+        let val = defaults.string(forKey: Keys.landingView) ?? Defaults.landingView
+        return LandingView(rawValue: val) ?? .timeTracking
+    }
+
+    /// Days before a due date to send a reminder, stored as a comma-separated list.
+    static var reminderLeadDays: [Int] {
+        let val = defaults.string(forKey: Keys.reminderLeadDays)
+            ?? Defaults.reminderLeadDays
+        return parseLeadDays(val)
+    }
+
+    static func parseLeadDays(_ value: String) -> [Int] {
+        Set(value.split(separator: ",").compactMap { Int($0) }.filter { $0 > 0 })
+            .sorted()
+    }
+
+    /// Visible row fields in display order; unknown and repeated names are dropped.
+    static func parseRowFields(_ value: String) -> [TodoRowField] {
+        var seen: Set<TodoRowField> = []
+        return value.split(separator: ",")
+            .compactMap { TodoRowField(rawValue: String($0)) }
+            .filter { seen.insert($0).inserted }
+    }
+
     // MARK: - Internal (centralized only, not in Settings UI)
 
     static var bitbucketCacheTTL: TimeInterval {
-        let val = UserDefaults.standard.double(forKey: Keys.bitbucketCacheTTL)
+        let val = defaults.double(forKey: Keys.bitbucketCacheTTL)
         return val > 0 ? val : Defaults.bitbucketCacheTTL
     }
 
     static var jiraCacheTTL: TimeInterval {
-        let val = UserDefaults.standard.double(forKey: Keys.jiraCacheTTL)
+        let val = defaults.double(forKey: Keys.jiraCacheTTL)
         return val > 0 ? val : Defaults.jiraCacheTTL
     }
 
     static var maxLogEntries: Int {
-        let val = UserDefaults.standard.integer(forKey: Keys.maxLogEntries)
+        let val = defaults.integer(forKey: Keys.maxLogEntries)
         return val > 0 ? val : Defaults.maxLogEntries
     }
+
+    static var dueCheckInterval: TimeInterval {
+        let val = defaults.double(forKey: Keys.dueCheckInterval)
+        return val > 0 ? val : Defaults.dueCheckInterval
+    }
+
+    static var shutdownTimeout: TimeInterval {
+        let val = defaults.double(forKey: Keys.shutdownTimeout)
+        return val > 0 ? val : Defaults.shutdownTimeout
+    }
+
+    /// Launched with `--demo`: an in-memory store seeded with sample data,
+    /// leaving the real store, settings, credentials and backups untouched.
+    static let isDemoMode = CommandLine.arguments.contains("--demo")
+
+    private static let demoSuiteName = "TaskManagement.demo"
+
+    /// Where settings and per-todo markers live. Demo launches get a
+    /// throwaway suite, reset on every launch, instead of the user's defaults.
+    static let defaults: UserDefaults = {
+        guard isDemoMode else { return .standard }
+        UserDefaults.standard.removePersistentDomain(forName: demoSuiteName)
+        // Never fall back to the real defaults, or the demo would overwrite them
+        guard let suite = UserDefaults(suiteName: demoSuiteName) else {
+            fatalError("Failed to open the demo settings suite \(demoSuiteName)")
+        }
+        return suite
+    }()
 }
@@ -1,4 +1,5 @@
 import SwiftUI
+import AppKit
 
 extension Color {
     init?(hex: String) {
@@ -17,3 +18,43 @@ extension Color {
         )
     }
 }
+
+extension Color {
+    /// Black or white, whichever reads better on the given hex background
+    /// (WCAG relative luminance; 0.179 is where black and white text have
+    /// equal contrast).
+    static func readableText(onHex hex: String) -> Color {
+        guard let rgb = NSColor(Color(hex: hex) ?? .gray).usingColorSpace(.sRGB) else {
+            return .primary
+        }
+        func linear(_ component: CGFloat) -> CGFloat {
+            component <= 0.03928 ? component / 12.92 : pow((component + 0.055) / 1.055, 2.4)
+        }
+        let luminance = 0.2126 * linear(rgb.redComponent)
+            + 0.7152 * linear(rgb.greenComponent)
+            + 0.0722 * linear(rgb.blueComponent)
+        return luminance > 0.179 ? .black : .white
+    }
+}
+
+extension String {
+    /// True for `#RRGGBB` or `RRGGBB`, the format `Color(hex:)` accepts.
+    var isValidHexColor: Bool {
+        let hex = trimmingCharacters(in: .whitespacesAndNewlines)
+        let digits = hex.hasPrefix("#") ? hex.dropFirst() : Substring(hex)
+        return digits.count == 6 && digits.allSatisfy(\.isHexDigit)
+    }
+}
+
+extension Color {
+    /// `#RRGGBB` in sRGB, or nil when the color can't be converted.
+    var hexString: String? {
+        guard let rgb = NSColor(self).usingColorSpace(.sRGB) else { return nil }
+        return String(
+            format: "#%02X%02X%02X",
+            Int((rgb.redComponent * 255).rounded()),
+            Int((rgb.greenComponent * 255).rounded()),
+            Int((rgb.blueComponent * 255).rounded())
+        )
+    }
+}
@@ -39,6 +39,27 @@ extension TimeInterval {
 // MARK: - Shared DateFormatters
 
 enum Formatters {
+    /// Whole calendar days from today to `date`; negative once it's past.
+    static func daysUntil(_ date: Date, now: Date = Date()) -> Int {
+        let calendar = Calendar.current
+        return calendar.dateComponents(
+            [.day],
+            from: calendar.startOfDay(for: now),
+            to: calendar.startOfDay(for: date)
+        ).day ?? 0
+    }
+
+    /// `"due today"`, `"due tomorrow"`, `"due in 3d"`, `"1d overdue"`.
+    static func relativeDue(_ date: Date, now: Date = Date()) -> String {
+        let days = daysUntil(date, now: now)
+        switch days {
+        case 0: return "due today"
+        case 1: return "due tomorrow"
+        case 2...: return "due in \(days)d"
+        default: return "\(-days)d overdue"
+        }
+    }
+
     static let shortTime: DateFormatter = {
         let formatter = DateFormatter()
         formatter.timeStyle = .short
@@ -51,6 +72,31 @@ enum Formatters {
         return formatter
     }()
 
+    static let dateTime: DateFormatter = {
+        let formatter = DateFormatter()
+        formatter.dateStyle = .medium
+        formatter.timeStyle = .short
+        return formatter
+    }()
+
+    /// Jira REST timestamp, e.g. `2024-03-01T09:30:00.000+0100`.
+    static let jiraTimestamp: DateFormatter = {
+        let formatter = DateFormatter()
+        formatter.locale = Locale(identifier: "en_US_POSIX")
+        formatter.dateFormat = "yyyy-MM-dd'T'HH:mm:ss.SSSZ"
+        return formatter
+    }()
+
+    /// Jira date-only fields such as `duedate`, e.g. `2024-03-01`, read as
+    /// that day in the local time zone.
+    static let jiraDay: DateFormatter = {
+        let formatter = DateFormatter()
+        formatter.locale = Locale(identifier: "en_US_POSIX")
+        formatter.dateFormat = "yyyy-MM-dd"
+        formatter.isLenient = false
+        return formatter
+    }()
+
     static func timeRange(start: Date, end: Date?) -> String {
         let startText = shortTime.string(from: start)
         if let end {
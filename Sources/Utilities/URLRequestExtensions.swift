@@ -0,0 +1,45 @@
+import Foundation
+
+/// Validators captured from a previous response. Replaying them lets the
+/// server answer an unchanged resource with a bodyless 304.
+struct HTTPValidators {
+    let etag: String?
+    let lastModified: String?
+
+    init?(response: HTTPURLResponse) {
+        etag = response.value(forHTTPHeaderField: "ETag")
+        lastModified = response.value(forHTTPHeaderField: "Last-Modified")
+        if etag == nil && lastModified == nil { return nil }
+    }
+}
+
+extension URLRequest {
+    /// Server/Data Center instances authenticate with a personal access token
+    /// as bearer; Atlassian Cloud uses basic auth with the account email (or
+    /// username) and an API token / app password.
+    mutating func setAtlassianAuthorization(
+        token: String, username: String, isCloud: Bool
+    ) {
+        if isCloud {
+            let credentials = Data("\(username):\(token)".utf8)
+                .base64EncodedString()
+            setValue("Basic \(credentials)", forHTTPHeaderField: "Authorization")
+        } else {
+            setValue("Bearer \(token)", forHTTPHeaderField: "Authorization")
+        }
+    }
+
+    /// Sends `If-None-Match` / `If-Modified-Since` from `validators`. The local
+    /// URL cache is bypassed so a 304 reaches the caller instead of being
+    /// swapped for a cached 200.
+    mutating func setConditionalHeaders(_ validators: HTTPValidators?) {
+        cachePolicy = .reloadIgnoringLocalCacheData
+        guard let validators else { return }
+        if let etag = validators.etag {
+            setValue(etag, forHTTPHeaderField: "If-None-Match")
+        }
+        if let lastModified = validators.lastModified {
+            setValue(lastModified, forHTTPHeaderField: "If-Modified-Since")
+        }
+    }
+}
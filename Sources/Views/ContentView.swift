@@ -1,17 +1,103 @@
 import SwiftUI
 import SwiftData
+import AppKit
 
 enum NavigationItem: Hashable {
     case todos(SidebarFilter)
     case timeTracking
+
+    /// Stable string used to remember the selection across launches.
+    var storageValue: String {
+        switch self {
+        case .timeTracking: "timeTracking"
+        case .todos(.all): "todos.all"
+        case .todos(.reference): "todos.reference"
+        case .todos(.snoozed): "todos.snoozed"
+        case .todos(.completed): "todos.completed"
+        case .todos(.archive): "todos.archive"
+        case .todos(.trash): "todos.trash"
+        case .todos(.project(let project)): "project.\(project.id.uuidString)"
+        case .todos(.tag(let tag)): "tag.\(tag.id.uuidString)"
+        }
+    }
+
+    /// Resolves a `storageValue`, looking up projects and tags in `context`.
+    /// Returns nil when the project or tag no longer exists.
+    static func restore(_ value: String, context: ModelContext) -> NavigationItem? {
+        switch value {
+        case "timeTracking": return .timeTracking
+        case "todos.all": return .todos(.all)
+        case "todos.reference": return .todos(.reference)
+        case "todos.snoozed": return .todos(.snoozed)
+        case "todos.completed": return .todos(.completed)
+        case "todos.archive": return .todos(.archive)
+        case "todos.trash": return .todos(.trash)
+        default: break
+        }
+        let parts = value.split(separator: ".", maxSplits: 1).map(String.init)
+        guard parts.count == 2, let id = UUID(uuidString: parts[1]) else { return nil }
+        switch parts[0] {
+        case "project":
+            let descriptor = FetchDescriptor<Project>(
+                predicate: #Predicate { $0.id == id && $0.deletedAt == nil }
+            )
+            return (try? context.fetch(descriptor).first).map { .todos(.project($0)) }
+        case "tag":
+            let descriptor = FetchDescriptor<Tag>(predicate: #Predicate { $0.id == id })
+            return (try? context.fetch(descriptor).first).map { .todos(.tag($0)) }
+        default:
+            return nil
+        }
+    }
+}
+
+enum LandingView: String, CaseIterable, Identifiable {
+    case timeTracking
+    case allTodos
+    case reference
+    case snoozed
+    case completed
+    case lastUsed
+
+    var id: String { rawValue }
+
+    var label: String {
+        switch self {
+        case .timeTracking: "Time Tracking"
+        case .allTodos: "All Todos"
+        case .reference: "Reference"
+        case .snoozed: "Snoozed"
+        case .completed: "Completed"
+        case .lastUsed: "Where I Left Off"
+        }
+    }
+
+    /// Starting item; `lastUsed` starts at All Todos until the saved
+    /// session has been restored.
+    var navigationItem: NavigationItem {
+        switch self {
+        case .timeTracking: .timeTracking
+        case .allTodos, .lastUsed: .todos(.all)
+        case .reference: .todos(.reference)
+        case .snoozed: .todos(.snoozed)
+        case .completed: .todos(.completed)
+        }
+    }
 }
 
 struct ContentView: View {
     @Environment(\.modelContext) private var modelContext
     @Environment(\.logService) private var logService
-    @State private var sidebarSelection: NavigationItem? = .timeTracking
+    @State private var sidebarSelection: NavigationItem? = AppConfig.landingView.navigationItem
     @State private var selectedTodo: Todo?
-    @State private var showLogPanel = false
+    // Last selected todo per sidebar item, restored when navigating back
+    @State private var selectionByItem: [NavigationItem: Todo] = [:]
+    // Topmost visible row per list, so switching back keeps the scroll position
+    @State private var scrollAnchorByFilter: [SidebarFilter: UUID] = [:]
+    @AppStorage(AppConfig.Keys.showLogPanel)
+    private var showLogPanel = false
+    @State private var showQuickOpen = false
+    @State private var showWeeklyReview = false
 
     var body: some View {
         NavigationSplitView {
@@ -43,7 +129,33 @@ struct ContentView: View {
             }
         }
         .frame(minWidth: 800, minHeight: 500)
+        .sheet(isPresented: $showQuickOpen) {
+            QuickOpenView(onOpen: open)
+        }
+        .sheet(isPresented: $showWeeklyReview) {
+            WeeklyReviewView()
+        }
         .toolbar {
+            ToolbarItem(placement: .automatic) {
+                PomodoroStatusView()
+            }
+            ToolbarItem(placement: .automatic) {
+                Button {
+                    showQuickOpen = true
+                } label: {
+                    Image(systemName: "magnifyingglass")
+                }
+                .keyboardShortcut("p", modifiers: .command)
+                .help("Quick Open (⌘P)")
+            }
+            ToolbarItem(placement: .automatic) {
+                Button {
+                    showWeeklyReview = true
+                } label: {
+                    Image(systemName: "calendar.badge.checkmark")
+                }
+                .help("Weekly Review")
+            }
             ToolbarItem(placement: .automatic) {
                 Button {
                     showLogPanel.toggle()
@@ -59,19 +171,60 @@ struct ContentView: View {
                 .help("Settings")
             }
         }
-        .onChange(of: sidebarSelection) { _, _ in
-            selectedTodo = nil
+        .onChange(of: sidebarSelection) { oldValue, newValue in
+            if let oldValue {
+                selectionByItem[oldValue] = selectedTodo
+            }
+            selectedTodo = newValue
+                .flatMap { selectionByItem[$0] }
+                .flatMap { $0.modelContext == nil || $0.isDeleted ? nil : $0 }
+            AppConfig.defaults.set(
+                newValue?.storageValue, forKey: AppConfig.Keys.lastNavigationItem
+            )
+        }
+        .onChange(of: selectedTodo) {
+            AppConfig.defaults.set(
+                selectedTodo?.id.uuidString, forKey: AppConfig.Keys.lastSelectedTodoID
+            )
+        }
+        .onAppear {
+            if AppConfig.landingView == .lastUsed {
+                restoreLastSession()
+            }
+        }
+    }
+
+    private func restoreLastSession() {
+        let defaults = AppConfig.defaults
+        guard let value = defaults.string(forKey: AppConfig.Keys.lastNavigationItem),
+              let item = NavigationItem.restore(value, context: modelContext) else { return }
+        if let idString = defaults.string(forKey: AppConfig.Keys.lastSelectedTodoID),
+           let id = UUID(uuidString: idString) {
+            let descriptor = FetchDescriptor<Todo>(predicate: #Predicate { $0.id == id })
+            if let todo = try? modelContext.fetch(descriptor).first {
+                // Picked up by the sidebarSelection onChange handler
+                selectionByItem[item] = todo
+            }
+        }
+        if sidebarSelection == item {
+            selectedTodo = selectionByItem[item]
+        } else {
+            sidebarSelection = item
         }
     }
 
     private func todoSplitView(filter: SidebarFilter) -> some View {
         HSplitView {
-            TodoListView(selectedTodo: $selectedTodo, filter: filter)
+            TodoListView(
+                selectedTodo: $selectedTodo,
+                scrollAnchors: $scrollAnchorByFilter,
+                filter: filter
+            )
                 .navigationTitle(filterTitle(filter))
                 .frame(minWidth: 250, idealWidth: 300)
 
             Group {
-                if let todo = selectedTodo {
+                if let todo = selectedTodo, !todo.isDeleted {
                     TodoDetailView(todo: todo)
                 } else {
                     VStack(spacing: 8) {
@@ -88,6 +241,34 @@ struct ContentView: View {
         }
     }
 
+    private func open(_ item: QuickOpenItem) {
+        switch item {
+        case .todo(let todo):
+            let target = NavigationItem.todos(homeFilter(for: todo))
+            if sidebarSelection == target {
+                selectedTodo = todo
+            } else {
+                // Restored by the sidebarSelection onChange handler
+                selectionByItem[target] = todo
+                sidebarSelection = target
+            }
+        case .project(let project):
+            sidebarSelection = .todos(.project(project))
+        case .tag(let tag):
+            sidebarSelection = .todos(.tag(tag))
+        }
+    }
+
+    /// The sidebar filter whose list contains the todo.
+    private func homeFilter(for todo: Todo) -> SidebarFilter {
+        if todo.isTrashed { return .trash }
+        if todo.isArchived { return .archive }
+        if todo.isCompleted { return .completed }
+        if todo.isSnoozed { return .snoozed }
+        if todo.isReference { return .reference }
+        return .all
+    }
+
     private var sidebarFilter: SidebarFilter? {
         if case .todos(let filter) = sidebarSelection {
             return filter
@@ -98,8 +279,12 @@ struct ContentView: View {
     private func filterTitle(_ filter: SidebarFilter) -> String {
         switch filter {
         case .all: "All Todos"
+        case .reference: "Reference"
+        case .snoozed: "Snoozed"
         case .project(let project): project.name
+        case .tag(let tag): tag.name
         case .completed: "Completed"
+        case .archive: "Archive"
         case .trash: "Trash"
         }
     }
@@ -109,6 +294,11 @@ struct ContentView: View {
 
 private struct LogPanelView: View {
     let logService: LogService
+    @State private var minimumLevel: LogLevel = .info
+
+    private var visibleEntries: [LogEntry] {
+        logService.entries.filter { $0.level >= minimumLevel }
+    }
 
     private static let timeFormatter: DateFormatter = {
         let formatter = DateFormatter()
@@ -119,16 +309,36 @@ private struct LogPanelView: View {
     var body: some View {
         VStack(spacing: 0) {
             Divider()
+            HStack {
+                Picker("Level", selection: $minimumLevel) {
+                    ForEach(LogLevel.allCases) { level in
+                        Text(level.label).tag(level)
+                    }
+                }
+                .pickerStyle(.segmented)
+                .labelsHidden()
+                .fixedSize()
+                Spacer()
+                if let url = logService.logFileURL {
+                    Button("Show Log File") {
+                        NSWorkspace.shared.activateFileViewerSelecting([url])
+                    }
+                    .buttonStyle(.link)
+                }
+            }
+            .font(.caption)
+            .padding(.horizontal, 8)
+            .padding(.vertical, 4)
             ScrollViewReader { proxy in
                 ScrollView {
                     LazyVStack(alignment: .leading, spacing: 2) {
-                        ForEach(logService.entries) { entry in
+                        ForEach(visibleEntries) { entry in
                             HStack(alignment: .top, spacing: 6) {
                                 Text(Self.timeFormatter.string(from: entry.timestamp))
                                     .foregroundStyle(.secondary)
                                 Text(entry.level.rawValue)
-                                    .foregroundStyle(entry.level == .error ? .red : .blue)
-                                    .frame(width: 40, alignment: .leading)
+                                    .foregroundStyle(entry.level.color)
+                                    .frame(width: 44, alignment: .leading)
                                 Text(entry.message)
                             }
                             .font(.system(size: 11, design: .monospaced))
@@ -140,13 +350,13 @@ private struct LogPanelView: View {
                     .padding(.vertical, 4)
                 }
                 .onChange(of: logService.entries.count) { _, _ in
-                    if let last = logService.entries.last {
+                    if let last = visibleEntries.last {
                         proxy.scrollTo(last.id, anchor: .bottom)
                     }
                 }
             }
         }
-        .frame(height: 150)
+        .frame(height: 180)
         .background(.background)
     }
 }
@@ -0,0 +1,53 @@
+import SwiftUI
+
+/// A pending change to Jira, held back until the user agrees to send it.
+struct JiraWriteRequest: Identifiable {
+    let id = UUID()
+    let summary: String
+    let perform: () -> Void
+
+    /// Runs `perform` right away when writes are already allowed; otherwise
+    /// returns a request for `jiraWriteConsent` to ask about.
+    static func make(
+        _ summary: String, perform: @escaping () -> Void
+    ) -> JiraWriteRequest? {
+        if AppConfig.jiraWritesAllowed {
+            perform()
+            return nil
+        }
+        return JiraWriteRequest(summary: summary, perform: perform)
+    }
+}
+
+/// Consent prompt shown before anything is written to Jira. "Always Allow"
+/// skips it from then on; the choice can be revoked in Settings > Integrations.
+struct JiraWriteConsent: ViewModifier {
+    @Binding var request: JiraWriteRequest?
+
+    func body(content: Content) -> some View {
+        content.confirmationDialog(
+            "Send to Jira?",
+            isPresented: .init(
+                get: { request != nil },
+                set: { if !$0 { request = nil } }
+            ),
+            titleVisibility: .visible,
+            presenting: request
+        ) { request in
+            Button("Send Once") { request.perform() }
+            Button("Always Allow") {
+                AppConfig.defaults.set(true, forKey: AppConfig.Keys.jiraWritesAllowed)
+                request.perform()
+            }
+            Button("Cancel", role: .cancel) {}
+        } message: { request in
+            Text("\(request.summary) This changes data on your Jira server for everyone on the project.")
+        }
+    }
+}
+
+extension View {
+    func jiraWriteConsent(_ request: Binding<JiraWriteRequest?>) -> some View {
+        modifier(JiraWriteConsent(request: request))
+    }
+}
@@ -0,0 +1,32 @@
+import SwiftUI
+
+/// Toolbar countdown for the running pomodoro, with a stop button.
+struct PomodoroStatusView: View {
+    @Environment(PomodoroService.self) private var pomodoro
+
+    var body: some View {
+        if let phase = pomodoro.phase, let endsAt = pomodoro.endsAt {
+            HStack(spacing: 6) {
+                Image(systemName: phase == .focus ? "timer" : "cup.and.saucer")
+                    .foregroundStyle(phase == .focus ? .red : .green)
+                TimelineView(.periodic(from: .now, by: 1)) { context in
+                    Text(Self.remaining(until: endsAt, now: context.date))
+                        .monospacedDigit()
+                }
+                Button {
+                    pomodoro.stop()
+                } label: {
+                    Image(systemName: "stop.fill")
+                }
+                .buttonStyle(.plain)
+                .help("Stop Pomodoro")
+            }
+            .help("\(phase.label): \(pomodoro.todoTitle ?? "")")
+        }
+    }
+
+    private static func remaining(until end: Date, now: Date) -> String {
+        let seconds = max(Int(end.timeIntervalSince(now).rounded()), 0)
+        return String(format: "%02d:%02d", seconds / 60, seconds % 60)
+    }
+}
@@ -0,0 +1,216 @@
+import SwiftUI
+import SwiftData
+
+enum QuickOpenItem: Identifiable {
+    case todo(Todo)
+    case project(Project)
+    case tag(Tag)
+
+    var id: String {
+        switch self {
+        case .todo(let todo): "todo-\(todo.id)"
+        case .project(let project): "project-\(project.id)"
+        case .tag(let tag): "tag-\(tag.id)"
+        }
+    }
+
+    var title: String {
+        switch self {
+        case .todo(let todo): todo.title
+        case .project(let project): project.name
+        case .tag(let tag): tag.name
+        }
+    }
+
+    /// Extra text matched against the query besides the title.
+    fileprivate var keywords: String {
+        switch self {
+        case .todo(let todo):
+            [todo.jiraLink?.ticketID, todo.project?.name]
+                .compactMap { $0 }
+                .joined(separator: " ")
+        case .project, .tag:
+            ""
+        }
+    }
+}
+
+/// ⌘P overlay that fuzzy-searches todos, projects and tags.
+struct QuickOpenView: View {
+    @Environment(\.dismiss) private var dismiss
+    @Query private var todos: [Todo]
+    @Query(filter: #Predicate<Project> { $0.deletedAt == nil }, sort: \Project.sortOrder)
+    private var projects: [Project]
+    @Query(sort: \Tag.name) private var tags: [Tag]
+
+    let onOpen: (QuickOpenItem) -> Void
+
+    @State private var query = ""
+    @State private var highlightedID: String?
+    @FocusState private var isSearchFocused: Bool
+
+    private static let resultLimit = 50
+
+    private var results: [QuickOpenItem] {
+        let items: [QuickOpenItem] =
+            projects.map { .project($0) }
+            + tags.map { .tag($0) }
+            + todos.filter { !$0.isTrashed }.map { .todo($0) }
+
+        guard !query.isEmpty else {
+            return Array(items.prefix(Self.resultLimit))
+        }
+        return items
+            .compactMap { item -> (item: QuickOpenItem, score: Int)? in
+                let titleScore = FuzzyMatcher.score(query, in: item.title)
+                let keywordScore = FuzzyMatcher.score(query, in: item.keywords).map { $0 / 2 }
+                guard let best = [titleScore, keywordScore].compactMap({ $0 }).max() else {
+                    return nil
+                }
+                return (item, best)
+            }
+            .sorted { $0.score > $1.score }
+            .prefix(Self.resultLimit)
+            .map(\.item)
+    }
+
+    var body: some View {
+        let results = results
+        let highlighted = results.first { $0.id == highlightedID } ?? results.first
+
+        VStack(spacing: 0) {
+            HStack(spacing: 8) {
+                Image(systemName: "magnifyingglass")
+                    .foregroundStyle(.secondary)
+                TextField("Search todos, projects and tags", text: $query)
+                    .textFieldStyle(.plain)
+                    .font(.title3)
+                    .focused($isSearchFocused)
+                    .onSubmit {
+                        if let highlighted { open(highlighted) }
+                    }
+            }
+            .padding(12)
+
+            Divider()
+
+            HStack(spacing: 0) {
+                ScrollViewReader { proxy in
+                    List(results) { item in
+                        resultRow(item, isHighlighted: item.id == highlighted?.id)
+                            .id(item.id)
+                            .contentShape(Rectangle())
+                            .onTapGesture { open(item) }
+                    }
+                    .listStyle(.plain)
+                    .onChange(of: highlightedID) {
+                        if let highlightedID { proxy.scrollTo(highlightedID) }
+                    }
+                }
+                .frame(width: 320)
+
+                Divider()
+
+                preview(highlighted)
+                    .frame(maxWidth: .infinity, maxHeight: .infinity, alignment: .topLeading)
+                    .padding()
+            }
+        }
+        .frame(width: 640, height: 400)
+        .onAppear { isSearchFocused = true }
+        .onChange(of: query) { highlightedID = nil }
+        .onKeyPress(.downArrow) {
+            moveHighlight(by: 1, in: results, from: highlighted)
+            return .handled
+        }
+        .onKeyPress(.upArrow) {
+            moveHighlight(by: -1, in: results, from: highlighted)
+            return .handled
+        }
+        .onExitCommand { dismiss() }
+    }
+
+    private func resultRow(_ item: QuickOpenItem, isHighlighted: Bool) -> some View {
+        HStack(spacing: 8) {
+            switch item {
+            case .todo(let todo):
+                Image(systemName: todo.isCompleted ? "checkmark.circle" : "circle")
+                    .foregroundStyle(.secondary)
+            case .project(let project):
+                Image(systemName: project.icon)
+                    .foregroundStyle(Color(hex: project.color) ?? .blue)
+            case .tag(let tag):
+                Image(systemName: "tag")
+                    .foregroundStyle(Color(hex: tag.color) ?? .gray)
+            }
+            Text(item.title)
+                .lineLimit(1)
+            Spacer()
+        }
+        .padding(.vertical, 2)
+        .padding(.horizontal, 4)
+        .background(
+            isHighlighted ? Color.accentColor.opacity(0.2) : .clear,
+            in: RoundedRectangle(cornerRadius: 4)
+        )
+    }
+
+    @ViewBuilder
+    private func preview(_ item: QuickOpenItem?) -> some View {
+        switch item {
+        case .todo(let todo):
+            VStack(alignment: .leading, spacing: 8) {
+                Text(todo.title)
+                    .font(.headline)
+                if let project = todo.project {
+                    ProjectChip(project: project)
+                        .font(.caption)
+                }
+                if let dueDate = todo.dueDate {
+                    Label(Formatters.mediumDate.string(from: dueDate), systemImage: "calendar")
+                        .font(.caption)
+                        .foregroundStyle(.secondary)
+                }
+                if !todo.descriptionText.isEmpty {
+                    Text(todo.descriptionText)
+                        .font(.callout)
+                        .foregroundStyle(.secondary)
+                        .lineLimit(10)
+                }
+            }
+        case .project(let project):
+            VStack(alignment: .leading, spacing: 8) {
+                ProjectChip(project: project)
+                    .font(.headline)
+                Text("\(project.todos.filter(\.isActive).count) open todos")
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+            }
+        case .tag(let tag):
+            VStack(alignment: .leading, spacing: 8) {
+                Label(tag.name, systemImage: "tag")
+                    .font(.headline)
+                Text("\(tag.todos.filter(\.isActive).count) open todos")
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+            }
+        case nil:
+            Text("No matches")
+                .foregroundStyle(.secondary)
+        }
+    }
+
+    private func moveHighlight(
+        by offset: Int, in results: [QuickOpenItem], from current: QuickOpenItem?
+    ) {
+        guard !results.isEmpty else { return }
+        let index = results.firstIndex { $0.id == current?.id } ?? 0
+        let next = min(max(index + offset, 0), results.count - 1)
+        highlightedID = results[next].id
+    }
+
+    private func open(_ item: QuickOpenItem) {
+        onOpen(item)
+        dismiss()
+    }
+}
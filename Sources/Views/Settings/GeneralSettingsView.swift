@@ -1,5 +1,7 @@
 import SwiftUI
 import SwiftData
+import AppKit
+import UniformTypeIdentifiers
 
 struct GeneralSettingsView: View {
     @Environment(\.modelContext) private var modelContext
@@ -20,9 +22,40 @@ struct GeneralSettingsView: View {
     private var dataRetentionDays = AppConfig.Defaults.dataRetentionDays
     @AppStorage(AppConfig.Keys.todoPurgeDays)
     private var todoPurgeDays = AppConfig.Defaults.todoPurgeDays
+    @AppStorage(AppConfig.Keys.landingView)
+    private var landingView = AppConfig.Defaults.landingView
+    @AppStorage(AppConfig.Keys.reminderLeadDays)
+    private var reminderLeadDays = AppConfig.Defaults.reminderLeadDays
+    @AppStorage(AppConfig.Keys.todoRowFields)
+    private var todoRowFields = AppConfig.Defaults.todoRowFields
+    @AppStorage(AppConfig.Keys.archiveAfterDays)
+    private var archiveAfterDays = AppConfig.Defaults.archiveAfterDays
+    @AppStorage(AppConfig.Keys.archiveRetentionDays)
+    private var archiveRetentionDays = AppConfig.Defaults.archiveRetentionDays
+    @AppStorage(AppConfig.Keys.autoBackupEnabled)
+    private var autoBackupEnabled = AppConfig.Defaults.autoBackupEnabled
+    @AppStorage(AppConfig.Keys.backupKeepCount)
+    private var backupKeepCount = AppConfig.Defaults.backupKeepCount
+    @AppStorage(AppConfig.Keys.worklogRoundingMinutes)
+    private var worklogRoundingMinutes = AppConfig.Defaults.worklogRoundingMinutes
+    @AppStorage(AppConfig.Keys.pomodoroFocusMinutes)
+    private var pomodoroFocusMinutes = AppConfig.Defaults.pomodoroFocusMinutes
+    @AppStorage(AppConfig.Keys.pomodoroBreakMinutes)
+    private var pomodoroBreakMinutes = AppConfig.Defaults.pomodoroBreakMinutes
 
     var body: some View {
         Form {
+            Section("Startup") {
+                Picker("Open at launch", selection: $landingView) {
+                    ForEach(LandingView.allCases) { view in
+                        Text(view.label).tag(view.rawValue)
+                    }
+                }
+                Text("Can be overridden with the --view launch argument.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+
             Section("Idle Detection") {
                 HStack {
                     Text("Idle timeout")
@@ -128,6 +161,85 @@ struct GeneralSettingsView: View {
                     .foregroundStyle(.tertiary)
             }
 
+            Section("List Rows") {
+                let visible = AppConfig.parseRowFields(todoRowFields)
+                let hidden = TodoRowField.allCases.filter { !visible.contains($0) }
+                ForEach(visible + hidden) { field in
+                    HStack {
+                        Toggle(field.label, isOn: rowFieldBinding(field))
+                        Spacer()
+                        Button {
+                            moveRowFieldUp(field)
+                        } label: {
+                            Image(systemName: "arrow.up")
+                        }
+                        .buttonStyle(.borderless)
+                        .disabled(visible.first == field || !visible.contains(field))
+                        .help("Show earlier")
+                    }
+                }
+                Text("Details shown for each todo in the list, in this order.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+
+            Section("Reminders") {
+                ForEach(ReminderLeadTime.allCases) { lead in
+                    Toggle(lead.label, isOn: leadDayBinding(lead.rawValue))
+                }
+                Text("Notify ahead of due dates. Individual todos can override this.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+
+            Section("Jira Worklogs") {
+                HStack {
+                    Text("Round up to")
+                    Spacer()
+                    Text("\(Int(worklogRoundingMinutes)) min")
+                        .foregroundStyle(.secondary)
+                        .monospacedDigit()
+                }
+                Slider(
+                    value: $worklogRoundingMinutes,
+                    in: 5...60,
+                    step: 5
+                )
+                Text("Each ticket's daily total is rounded up before logging to Jira.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+
+            Section("Pomodoro") {
+                HStack {
+                    Text("Focus")
+                    Spacer()
+                    Text("\(Int(pomodoroFocusMinutes)) min")
+                        .foregroundStyle(.secondary)
+                        .monospacedDigit()
+                }
+                Slider(
+                    value: $pomodoroFocusMinutes,
+                    in: 5...90,
+                    step: 5
+                )
+                HStack {
+                    Text("Break")
+                    Spacer()
+                    Text("\(Int(pomodoroBreakMinutes)) min")
+                        .foregroundStyle(.secondary)
+                        .monospacedDigit()
+                }
+                Slider(
+                    value: $pomodoroBreakMinutes,
+                    in: 1...30,
+                    step: 1
+                )
+                Text("Finished focus sessions are logged as timer entries on the todo.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+
             Section("Data Retention") {
                 HStack {
                     Text("Time entry retention")
@@ -160,6 +272,71 @@ struct GeneralSettingsView: View {
                 Text("Soft-deleted todos older than this are permanently removed.")
                     .font(.caption)
                     .foregroundStyle(.tertiary)
+
+                HStack {
+                    Text("Archive completed after")
+                    Spacer()
+                    Text("\(Int(archiveAfterDays)) days")
+                        .foregroundStyle(.secondary)
+                        .monospacedDigit()
+                }
+                Slider(
+                    value: $archiveAfterDays,
+                    in: 1...180,
+                    step: 1
+                )
+                Text("Used by \"Archive Old\" in the Completed list.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+
+                HStack {
+                    Text("Archived todo retention")
+                    Spacer()
+                    Text("\(Int(archiveRetentionDays)) days")
+                        .foregroundStyle(.secondary)
+                        .monospacedDigit()
+                }
+                Slider(
+                    value: $archiveRetentionDays,
+                    in: 30...730,
+                    step: 1
+                )
+                Text("Archived todos older than this are deleted at startup.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+
+            if !AppConfig.isDemoMode {
+                Section("Backups") {
+                    Toggle("Back up daily", isOn: $autoBackupEnabled)
+
+                    HStack {
+                        Text("Keep")
+                        Spacer()
+                        Text("\(Int(backupKeepCount)) backups")
+                            .foregroundStyle(.secondary)
+                            .monospacedDigit()
+                    }
+                    Slider(
+                        value: $backupKeepCount,
+                        in: 1...30,
+                        step: 1
+                    )
+
+                    HStack {
+                        Button("Back Up Now") { backUpNow() }
+                        Button("Restore…") { chooseBackupToRestore() }
+                        Spacer()
+                        Button("Show in Finder") {
+                            NSWorkspace.shared.open(BackupService.backupsDirectory)
+                        }
+                    }
+                    if let backupStatus {
+                        Text(backupStatus)
+                            .font(.caption)
+                            .foregroundStyle(.secondary)
+                    }
+                }
             }
 
             Section("Data") {
@@ -189,10 +366,95 @@ struct GeneralSettingsView: View {
         } message: {
             Text("This will permanently delete all time entries. This cannot be undone.")
         }
+        .confirmationDialog(
+            "Restore Backup?",
+            isPresented: .init(
+                get: { pendingRestoreURL != nil },
+                set: { if !$0 { pendingRestoreURL = nil } }
+            ),
+            titleVisibility: .visible
+        ) {
+            Button("Restore and Quit", role: .destructive) {
+                restoreBackup()
+            }
+        } message: {
+            Text("Current data will be replaced by \(pendingRestoreURL?.lastPathComponent ?? "the backup") the next time the app starts.")
+        }
     }
 
     @State private var showDeleteConfirmation = false
     @State private var errorMessage: String?
+    @State private var backupStatus: String?
+    @State private var pendingRestoreURL: URL?
+
+    private func leadDayBinding(_ days: Int) -> Binding<Bool> {
+        Binding(
+            get: { AppConfig.parseLeadDays(reminderLeadDays).contains(days) },
+            set: { isOn in
+                var selected = Set(AppConfig.parseLeadDays(reminderLeadDays))
+                if isOn {
+                    selected.insert(days)
+                } else {
+                    selected.remove(days)
+                }
+                reminderLeadDays = selected.sorted().map(String.init).joined(separator: ",")
+            }
+        )
+    }
+
+    private func rowFieldBinding(_ field: TodoRowField) -> Binding<Bool> {
+        Binding(
+            get: { AppConfig.parseRowFields(todoRowFields).contains(field) },
+            set: { isOn in
+                var fields = AppConfig.parseRowFields(todoRowFields)
+                fields.removeAll { $0 == field }
+                if isOn {
+                    fields.append(field)
+                }
+                todoRowFields = fields.map(\.rawValue).joined(separator: ",")
+            }
+        )
+    }
+
+    private func moveRowFieldUp(_ field: TodoRowField) {
+        var fields = AppConfig.parseRowFields(todoRowFields)
+        guard let index = fields.firstIndex(of: field), index > 0 else { return }
+        fields.swapAt(index, index - 1)
+        todoRowFields = fields.map(\.rawValue).joined(separator: ",")
+    }
+
+    private func backUpNow() {
+        guard let storeURL = modelContext.container.configurations.first?.url else { return }
+        do {
+            try modelContext.save()
+            let url = try BackupService(storeURL: storeURL).backup()
+            BackupService.rotate(keep: Int(backupKeepCount))
+            backupStatus = "Saved \(url.lastPathComponent)"
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+
+    private func chooseBackupToRestore() {
+        let panel = NSOpenPanel()
+        panel.directoryURL = BackupService.backupsDirectory
+        panel.allowedContentTypes = [UTType(filenameExtension: "store") ?? .data]
+        panel.allowsMultipleSelection = false
+        panel.message = "Choose a backup to restore"
+        if panel.runModal() == .OK {
+            pendingRestoreURL = panel.url
+        }
+    }
+
+    private func restoreBackup() {
+        guard let url = pendingRestoreURL else { return }
+        do {
+            try BackupService.scheduleRestore(from: url)
+            NSApplication.shared.terminate(nil)
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
 
     private func deleteAllEntries() {
         let service = serviceContainer!.makeTimeEntryService()
@@ -8,14 +8,27 @@ struct IntegrationSettingsView: View {
 
     @State private var jiraURL = ""
     @State private var jiraToken = ""
+    @State private var jiraEmail = ""
+    @State private var jiraIsCloud = false
+    @State private var jiraEnabled = true
     @State private var bitbucketURL = ""
     @State private var bitbucketToken = ""
+    @State private var bitbucketUsername = ""
+    @State private var bitbucketIsCloud = false
+    @State private var bitbucketEnabled = true
+    // Saved tokens stay in the keychain; the fields only hold replacements
+    @State private var hasStoredJiraToken = false
+    @State private var hasStoredBitbucketToken = false
+    @AppStorage(AppConfig.Keys.jiraWritesAllowed)
+    private var jiraWritesAllowed = false
 
     @State private var jiraStatus: ConnectionStatus?
     @State private var bbStatus: ConnectionStatus?
 
     @State private var jiraSaveTask: Task<Void, Never>?
     @State private var bbSaveTask: Task<Void, Never>?
+    @State private var jiraTestTask: Task<Void, Never>?
+    @State private var bbTestTask: Task<Void, Never>?
     @State private var errorMessage: String?
 
     var body: some View {
@@ -26,11 +39,19 @@ struct IntegrationSettingsView: View {
                     icon: "list.clipboard",
                     iconColor: .blue,
                     urlLabel: "Server URL",
-                    urlHint: "e.g. https://jira.company.com/jira",
+                    urlHint: jiraIsCloud
+                        ? "e.g. https://company.atlassian.net"
+                        : "e.g. https://jira.company.com/jira",
                     url: $jiraURL,
                     token: $jiraToken,
+                    hasStoredToken: hasStoredJiraToken,
+                    isCloud: $jiraIsCloud,
+                    username: $jiraEmail,
+                    usernameLabel: "Account Email",
+                    isEnabled: $jiraEnabled,
+                    writesAllowed: $jiraWritesAllowed,
                     status: jiraStatus,
-                    onTest: testJiraConnection
+                    onTest: { testJiraConnection(onSuccess: jiraPersistence()) }
                 )
 
                 integrationCard(
@@ -38,11 +59,18 @@ struct IntegrationSettingsView: View {
                     icon: "arrow.triangle.branch",
                     iconColor: .blue,
                     urlLabel: "Server URL",
-                    urlHint: "e.g. https://bitbucket.company.com",
+                    urlHint: bitbucketIsCloud
+                        ? "https://bitbucket.org"
+                        : "e.g. https://bitbucket.company.com",
                     url: $bitbucketURL,
                     token: $bitbucketToken,
+                    hasStoredToken: hasStoredBitbucketToken,
+                    isCloud: $bitbucketIsCloud,
+                    username: $bitbucketUsername,
+                    cloudTokenLabel: "App Password",
+                    isEnabled: $bitbucketEnabled,
                     status: bbStatus,
-                    onTest: testBitbucketConnection
+                    onTest: { testBitbucketConnection(onSuccess: bitbucketPersistence()) }
                 )
 
                 Spacer()
@@ -59,8 +87,21 @@ struct IntegrationSettingsView: View {
         }
         .onChange(of: jiraURL) { debouncedSaveJira() }
         .onChange(of: jiraToken) { debouncedSaveJira() }
+        .onChange(of: jiraEmail) { debouncedSaveJira() }
+        .onChange(of: jiraIsCloud) { debouncedSaveJira() }
+        .onChange(of: jiraEnabled) { saveEnabled(type: .jira, isEnabled: jiraEnabled) }
         .onChange(of: bitbucketURL) { debouncedSaveBitbucket() }
         .onChange(of: bitbucketToken) { debouncedSaveBitbucket() }
+        .onChange(of: bitbucketUsername) { debouncedSaveBitbucket() }
+        .onChange(of: bitbucketEnabled) {
+            saveEnabled(type: .bitbucket, isEnabled: bitbucketEnabled)
+        }
+        .onChange(of: bitbucketIsCloud) {
+            if bitbucketIsCloud && bitbucketURL.isEmpty {
+                bitbucketURL = "https://bitbucket.org"
+            }
+            debouncedSaveBitbucket()
+        }
         .onAppear { loadSettings() }
     }
 
@@ -74,10 +115,19 @@ struct IntegrationSettingsView: View {
         urlHint: String,
         url: Binding<String>,
         token: Binding<String>,
+        hasStoredToken: Bool,
+        isCloud: Binding<Bool>? = nil,
+        username: Binding<String>? = nil,
+        usernameLabel: String = "Username",
+        cloudTokenLabel: String = "API Token",
+        isEnabled: Binding<Bool>,
+        writesAllowed: Binding<Bool>? = nil,
         status: ConnectionStatus?,
         onTest: @escaping () -> Void
     ) -> some View {
-        VStack(alignment: .leading, spacing: 12) {
+        let cloud = isCloud?.wrappedValue ?? false
+        let enabled = isEnabled.wrappedValue
+        return VStack(alignment: .leading, spacing: 12) {
             HStack(spacing: 10) {
                 Image(systemName: icon)
                     .font(.title3)
@@ -89,12 +139,37 @@ struct IntegrationSettingsView: View {
 
                 Spacer()
 
-                statusBadge(status)
+                if enabled {
+                    statusBadge(status)
+                } else {
+                    Text("Disabled")
+                        .font(.caption)
+                        .foregroundStyle(.secondary)
+                        .padding(.horizontal, 8)
+                        .padding(.vertical, 3)
+                        .background(.secondary.opacity(0.08))
+                        .clipShape(Capsule())
+                }
+
+                Toggle("Enabled", isOn: isEnabled)
+                    .toggleStyle(.switch)
+                    .controlSize(.small)
+                    .labelsHidden()
+                    .help(enabled ? "Disable \(title)" : "Enable \(title)")
             }
 
             Divider()
 
             VStack(alignment: .leading, spacing: 8) {
+                if let isCloud {
+                    Picker("Deployment", selection: isCloud) {
+                        Text("Server / Data Center").tag(false)
+                        Text("Cloud").tag(true)
+                    }
+                    .pickerStyle(.segmented)
+                    .labelsHidden()
+                }
+
                 VStack(alignment: .leading, spacing: 4) {
                     Text(urlLabel)
                         .font(.subheadline)
@@ -103,21 +178,42 @@ struct IntegrationSettingsView: View {
                         .textFieldStyle(.roundedBorder)
                 }
 
+                if cloud, let username {
+                    VStack(alignment: .leading, spacing: 4) {
+                        Text(usernameLabel)
+                            .font(.subheadline)
+                            .foregroundStyle(.secondary)
+                        TextField(usernameLabel, text: username)
+                            .textFieldStyle(.roundedBorder)
+                    }
+                }
+
                 VStack(alignment: .leading, spacing: 4) {
-                    Text("Personal Access Token")
+                    Text(cloud ? cloudTokenLabel : "Personal Access Token")
                         .font(.subheadline)
                         .foregroundStyle(.secondary)
-                    SecureField("Enter token", text: token)
-                        .textFieldStyle(.roundedBorder)
+                    SecureField(
+                        "",
+                        text: token,
+                        prompt: Text(hasStoredToken ? "Leave blank to keep current token" : "Enter token")
+                    )
+                    .textFieldStyle(.roundedBorder)
+                }
+
+                if let writesAllowed {
+                    Toggle("Send worklogs and new issues without asking", isOn: writesAllowed)
+                        .font(.subheadline)
                 }
             }
+            .disabled(!enabled)
 
             HStack {
                 Button("Test Connection") { onTest() }
                     .controlSize(.small)
                     .disabled(
                         url.wrappedValue.isEmpty
-                        || token.wrappedValue.isEmpty
+                        || (token.wrappedValue.isEmpty && !hasStoredToken)
+                        || (cloud && username?.wrappedValue.isEmpty ?? true)
                         || status == .testing
                     )
 
@@ -134,8 +230,10 @@ struct IntegrationSettingsView: View {
                         .lineLimit(2)
                 }
             }
+            .disabled(!enabled)
         }
         .padding()
+        .opacity(enabled ? 1 : 0.6)
         .background(.background)
         .clipShape(RoundedRectangle(cornerRadius: 8))
         .overlay(
@@ -207,67 +305,146 @@ struct IntegrationSettingsView: View {
     private func loadSettings() {
         let jiraConfig = configs.first { $0.type == .jira }
         jiraURL = jiraConfig?.serverURL ?? ""
-        jiraToken = (try? KeychainService.retrieve(key: "jira_token")) ?? ""
+        jiraEmail = jiraConfig?.username ?? ""
+        jiraIsCloud = jiraConfig?.isCloud ?? false
+        jiraEnabled = jiraConfig?.isEnabled ?? true
+        jiraToken = ""
+        hasStoredJiraToken = !storedToken(key: "jira_token").isEmpty
 
         let bbConfig = configs.first { $0.type == .bitbucket }
         bitbucketURL = bbConfig?.serverURL ?? ""
-        bitbucketToken =
-            (try? KeychainService.retrieve(key: "bitbucket_token")) ?? ""
+        bitbucketUsername = bbConfig?.username ?? ""
+        bitbucketIsCloud = bbConfig?.isCloud ?? false
+        bitbucketEnabled = bbConfig?.isEnabled ?? true
+        bitbucketToken = ""
+        hasStoredBitbucketToken = !storedToken(key: "bitbucket_token").isEmpty
 
-        if !jiraURL.isEmpty && !jiraToken.isEmpty {
+        if jiraEnabled && !jiraURL.isEmpty && hasStoredJiraToken {
             testJiraConnection()
         }
-        if !bitbucketURL.isEmpty && !bitbucketToken.isEmpty {
+        if bitbucketEnabled && !bitbucketURL.isEmpty && hasStoredBitbucketToken {
             testBitbucketConnection()
         }
     }
 
+    private func storedToken(key: String) -> String {
+        (try? KeychainService.retrieve(key: key)) ?? ""
+    }
+
+    /// The token typed into the form, or the saved one when the field is blank.
+    private var effectiveJiraToken: String {
+        jiraToken.isEmpty ? storedToken(key: "jira_token") : jiraToken
+    }
+
+    private var effectiveBitbucketToken: String {
+        bitbucketToken.isEmpty ? storedToken(key: "bitbucket_token") : bitbucketToken
+    }
+
+    // Edits are validated against the server before anything is written,
+    // so a mistyped URL or token never replaces a working configuration.
     private func debouncedSaveJira() {
         jiraSaveTask?.cancel()
+        jiraTestTask?.cancel()
         jiraSaveTask = Task {
             try? await Task.sleep(for: .milliseconds(500))
             guard !Task.isCancelled else { return }
-            saveConfig(type: .jira, url: jiraURL, username: "")
-            if !jiraToken.isEmpty {
-                do {
-                    try KeychainService.store(
-                        key: "jira_token", value: jiraToken
-                    )
-                } catch {
-                    errorMessage = error.localizedDescription
-                }
-            }
+            guard jiraEnabled, !jiraURL.isEmpty,
+                  !effectiveJiraToken.isEmpty
+            else { return }
+            testJiraConnection(onSuccess: jiraPersistence())
         }
     }
 
     private func debouncedSaveBitbucket() {
         bbSaveTask?.cancel()
+        bbTestTask?.cancel()
         bbSaveTask = Task {
             try? await Task.sleep(for: .milliseconds(500))
             guard !Task.isCancelled else { return }
+            guard bitbucketEnabled, !bitbucketURL.isEmpty,
+                  !effectiveBitbucketToken.isEmpty
+            else { return }
+            testBitbucketConnection(onSuccess: bitbucketPersistence())
+        }
+    }
+
+    /// Captures the current Jira form values and returns a closure that
+    /// saves exactly those once the connection test has passed.
+    private func jiraPersistence() -> () -> Void {
+        let url = jiraURL
+        let email = jiraEmail
+        let isCloud = jiraIsCloud
+        let isEnabled = jiraEnabled
+        let token = jiraToken
+        return {
             saveConfig(
-                type: .bitbucket, url: bitbucketURL, username: ""
+                type: .jira, url: url, username: email,
+                isCloud: isCloud, isEnabled: isEnabled
             )
-            if !bitbucketToken.isEmpty {
-                do {
-                    try KeychainService.store(
-                        key: "bitbucket_token", value: bitbucketToken
-                    )
-                } catch {
-                    errorMessage = error.localizedDescription
-                }
+            guard !token.isEmpty else { return }
+            do {
+                try KeychainService.store(key: "jira_token", value: token)
+                hasStoredJiraToken = true
+            } catch {
+                errorMessage = error.localizedDescription
+            }
+        }
+    }
+
+    private func bitbucketPersistence() -> () -> Void {
+        let url = bitbucketURL
+        let username = bitbucketUsername
+        let isCloud = bitbucketIsCloud
+        let isEnabled = bitbucketEnabled
+        let token = bitbucketToken
+        return {
+            saveConfig(
+                type: .bitbucket, url: url, username: username,
+                isCloud: isCloud, isEnabled: isEnabled
+            )
+            guard !token.isEmpty else { return }
+            do {
+                try KeychainService.store(
+                    key: "bitbucket_token", value: token
+                )
+                hasStoredBitbucketToken = true
+            } catch {
+                errorMessage = error.localizedDescription
             }
         }
     }
 
     // MARK: - Test Connections
 
-    private func testJiraConnection() {
+    /// The connection details a test ran with. A result is only applied
+    /// while the form still holds the same values.
+    private struct ConnectionForm: Equatable {
+        let url: String
+        let username: String
+        let token: String
+        let isCloud: Bool
+    }
+
+    private var jiraForm: ConnectionForm {
+        ConnectionForm(url: jiraURL, username: jiraEmail, token: jiraToken, isCloud: jiraIsCloud)
+    }
+
+    private var bitbucketForm: ConnectionForm {
+        ConnectionForm(
+            url: bitbucketURL, username: bitbucketUsername,
+            token: bitbucketToken, isCloud: bitbucketIsCloud
+        )
+    }
+
+    private func testJiraConnection(onSuccess: (() -> Void)? = nil) {
+        jiraTestTask?.cancel()
         jiraStatus = .testing
+        let form = jiraForm
 
         let baseURL = jiraURL
             .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
-        guard let url = URL(string: "\(baseURL)/rest/api/2/myself")
+        let apiPath = JiraService.apiPath(isCloud: jiraIsCloud)
+        guard let url = URL(string: "\(baseURL)\(apiPath)/myself")
         else {
             jiraStatus = .error("Invalid server URL")
             return
@@ -277,15 +454,15 @@ struct IntegrationSettingsView: View {
         request.setValue(
             "application/json", forHTTPHeaderField: "Accept"
         )
-        request.setValue(
-            "Bearer \(jiraToken)",
-            forHTTPHeaderField: "Authorization"
+        request.setAtlassianAuthorization(
+            token: effectiveJiraToken, username: jiraEmail, isCloud: jiraIsCloud
         )
 
-        Task {
+        jiraTestTask = Task {
             do {
                 let (data, response) =
                     try await URLSession.shared.data(for: request)
+                guard !Task.isCancelled, form == jiraForm else { return }
                 guard let http = response as? HTTPURLResponse else {
                     jiraStatus = .error("No response from server")
                     return
@@ -309,7 +486,9 @@ struct IntegrationSettingsView: View {
                     }
                 } else if http.statusCode == 401 {
                     jiraStatus = .error(
-                        "Authentication failed — check your token"
+                        jiraIsCloud
+                            ? "Authentication failed — check your email and API token"
+                            : "Authentication failed — check your token"
                     )
                 } else if http.statusCode == 403 {
                     jiraStatus = .error(
@@ -321,20 +500,30 @@ struct IntegrationSettingsView: View {
                     )
                 }
             } catch {
+                guard !Task.isCancelled, form == jiraForm else { return }
                 jiraStatus = .error(
                     "Connection failed:"
                     + " \(error.localizedDescription)"
                 )
             }
+            if case .connected = jiraStatus {
+                onSuccess?()
+            }
         }
     }
 
-    private func testBitbucketConnection() {
+    private func testBitbucketConnection(onSuccess: (() -> Void)? = nil) {
+        bbTestTask?.cancel()
         bbStatus = .testing
+        let form = bitbucketForm
+        let token = effectiveBitbucketToken
 
         let baseURL = bitbucketURL
             .trimmingCharacters(in: CharacterSet(charactersIn: "/"))
-        let urlString = "\(baseURL)/rest/api/1.0/users"
+        let isCloud = bitbucketIsCloud
+        let urlString = isCloud
+            ? "\(BrowserTabService.bitbucketCloudAPIBase)/user"
+            : "\(baseURL)/rest/api/1.0/users"
 
         guard let url = URL(string: urlString) else {
             bbStatus = .error("Invalid server URL")
@@ -345,28 +534,38 @@ struct IntegrationSettingsView: View {
         request.setValue(
             "application/json", forHTTPHeaderField: "Accept"
         )
-        request.setValue(
-            "Bearer \(bitbucketToken)",
-            forHTTPHeaderField: "Authorization"
+        request.setAtlassianAuthorization(
+            token: token, username: bitbucketUsername,
+            isCloud: isCloud
         )
 
-        Task {
+        bbTestTask = Task {
             do {
-                let (_, response) =
+                let (data, response) =
                     try await URLSession.shared.data(for: request)
+                guard !Task.isCancelled, form == bitbucketForm else { return }
                 guard let http = response as? HTTPURLResponse else {
                     bbStatus = .error("No response from server")
                     return
                 }
 
-                if http.statusCode == 200 {
+                if http.statusCode == 200, isCloud {
+                    let json = try? JSONSerialization.jsonObject(
+                        with: data
+                    ) as? [String: Any]
+                    let name = json?["display_name"] as? String
+                    bbStatus = .connected(
+                        name.map { "Connected as \($0)" } ?? "Connected"
+                    )
+                } else if http.statusCode == 200 {
                     let username = http.value(
                         forHTTPHeaderField: "X-AUSERNAME"
                     )
                     if let username, !username.isEmpty {
                         let displayName = await fetchBBDisplayName(
-                            baseURL: baseURL, username: username
+                            baseURL: baseURL, username: username, token: token
                         )
+                        guard !Task.isCancelled, form == bitbucketForm else { return }
                         bbStatus = .connected(
                             "Connected as \(displayName ?? username)"
                         )
@@ -375,7 +574,9 @@ struct IntegrationSettingsView: View {
                     }
                 } else if http.statusCode == 401 {
                     bbStatus = .error(
-                        "Authentication failed — check your token"
+                        isCloud
+                            ? "Authentication failed — check your username and app password"
+                            : "Authentication failed — check your token"
                     )
                 } else if http.statusCode == 403 {
                     bbStatus = .error(
@@ -387,16 +588,20 @@ struct IntegrationSettingsView: View {
                     )
                 }
             } catch {
+                guard !Task.isCancelled, form == bitbucketForm else { return }
                 bbStatus = .error(
                     "Connection failed:"
                     + " \(error.localizedDescription)"
                 )
             }
+            if case .connected = bbStatus {
+                onSuccess?()
+            }
         }
     }
 
     private func fetchBBDisplayName(
-        baseURL: String, username: String
+        baseURL: String, username: String, token: String
     ) async -> String? {
         guard let url = URL(
             string: "\(baseURL)/rest/api/1.0/users/\(username)"
@@ -407,7 +612,7 @@ struct IntegrationSettingsView: View {
             "application/json", forHTTPHeaderField: "Accept"
         )
         request.setValue(
-            "Bearer \(bitbucketToken)",
+            "Bearer \(token)",
             forHTTPHeaderField: "Authorization"
         )
 
@@ -424,17 +629,36 @@ struct IntegrationSettingsView: View {
 
     // MARK: - Persistence
 
+    // Toggling an integration doesn't touch its connection details, so it
+    // is saved straight away without a round trip to the server.
+    private func saveEnabled(type: IntegrationType, isEnabled: Bool) {
+        guard let existing = configs.first(where: { $0.type == type }) else {
+            return
+        }
+        existing.isEnabled = isEnabled
+        do {
+            try modelContext.save()
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+
     private func saveConfig(
-        type: IntegrationType, url: String, username: String
+        type: IntegrationType, url: String, username: String,
+        isCloud: Bool = false, isEnabled: Bool = true
     ) {
         if let existing = configs.first(where: { $0.type == type }) {
             existing.serverURL = url
             existing.username = username
+            existing.isCloud = isCloud
+            existing.isEnabled = isEnabled
         } else {
             let config = IntegrationConfig(
                 type: type,
                 serverURL: url,
-                username: username
+                username: username,
+                isEnabled: isEnabled,
+                isCloud: isCloud
             )
             modelContext.insert(config)
         }
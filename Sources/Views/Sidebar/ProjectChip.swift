@@ -0,0 +1,18 @@
+import SwiftUI
+
+/// Project icon tinted with the project color, followed by its name.
+struct ProjectChip: View {
+    let project: Project
+    var showsName = true
+
+    var body: some View {
+        HStack(spacing: 4) {
+            Image(systemName: project.icon)
+                .foregroundStyle(Color(hex: project.color) ?? .blue)
+            if showsName {
+                Text(project.name)
+                    .lineLimit(1)
+            }
+        }
+    }
+}
@@ -0,0 +1,111 @@
+import SwiftUI
+
+struct ProjectEditView: View {
+    @Environment(\.modelContext) private var modelContext
+    @Environment(\.serviceContainer) private var serviceContainer
+    @Environment(\.dismiss) private var dismiss
+    let project: Project
+
+    @State private var name = ""
+    @State private var colorHex = ""
+    @State private var icon = ""
+    @State private var errorMessage: String?
+
+    static let icons = [
+        "folder", "briefcase", "hammer", "wrench.and.screwdriver", "doc.text",
+        "book", "lightbulb", "star", "flag", "cart", "house", "person.2",
+        "chart.bar", "globe", "server.rack", "ladybug", "paintbrush", "graduationcap",
+    ]
+
+    private var colorBinding: Binding<Color> {
+        Binding(
+            get: { Color(hex: colorHex) ?? .blue },
+            set: { newValue in
+                if let hex = newValue.hexString {
+                    colorHex = hex
+                }
+            }
+        )
+    }
+
+    var body: some View {
+        VStack(alignment: .leading, spacing: 16) {
+            Text("Edit Project")
+                .font(.headline)
+
+            Form {
+                TextField("Name", text: $name)
+
+                HStack {
+                    TextField("Color", text: $colorHex)
+                        .font(.system(.body, design: .monospaced))
+                    ColorPicker("", selection: colorBinding, supportsOpacity: false)
+                        .labelsHidden()
+                }
+                if !colorHex.isValidHexColor {
+                    Text("Use a hex color like #007AFF")
+                        .font(.caption)
+                        .foregroundStyle(.red)
+                }
+
+                LazyVGrid(columns: Array(repeating: GridItem(.fixed(28)), count: 9), spacing: 6) {
+                    ForEach(Self.icons, id: \.self) { symbol in
+                        Button {
+                            icon = symbol
+                        } label: {
+                            Image(systemName: symbol)
+                                .frame(width: 24, height: 24)
+                                .foregroundStyle(Color(hex: colorHex) ?? .blue)
+                                .background(
+                                    symbol == icon ? Color.accentColor.opacity(0.2) : .clear,
+                                    in: RoundedRectangle(cornerRadius: 4)
+                                )
+                        }
+                        .buttonStyle(.plain)
+                    }
+                }
+            }
+
+            HStack {
+                Spacer()
+                Button("Cancel", role: .cancel) { dismiss() }
+                    .keyboardShortcut(.cancelAction)
+                Button("Save") { save() }
+                    .buttonStyle(.borderedProminent)
+                    .keyboardShortcut(.defaultAction)
+                    .disabled(!colorHex.isValidHexColor || name.trimmingCharacters(in: .whitespaces).isEmpty)
+            }
+        }
+        .padding(20)
+        .frame(width: 360)
+        .onAppear {
+            name = project.name
+            colorHex = project.color
+            icon = project.icon
+        }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+    }
+
+    private func save() {
+        let projectService = serviceContainer!.makeProjectService(context: modelContext)
+        let hex = colorHex.trimmingCharacters(in: .whitespaces).uppercased()
+        do {
+            try projectService.update(
+                project,
+                name: name,
+                color: hex.hasPrefix("#") ? hex : "#" + hex,
+                icon: icon
+            )
+            dismiss()
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+}
@@ -5,12 +5,7 @@ struct ProjectRow: View {
 
     var body: some View {
         HStack(spacing: 8) {
-            Circle()
-                .fill(Color(hex: project.color) ?? .blue)
-                .frame(width: 10, height: 10)
-
-            Text(project.name)
-                .lineLimit(1)
+            ProjectChip(project: project)
 
             Spacer()
 
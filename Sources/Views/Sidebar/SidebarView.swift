@@ -3,20 +3,28 @@ import SwiftData
 
 enum SidebarFilter: Hashable {
     case all
+    case reference
+    case snoozed
     case project(Project)
+    case tag(Tag)
     case completed
+    case archive
     case trash
 }
 
 struct SidebarView: View {
     @Environment(\.modelContext) private var modelContext
     @Environment(\.serviceContainer) private var serviceContainer
-    @Query(sort: \Project.sortOrder) private var projects: [Project]
+    @Query(filter: #Predicate<Project> { $0.deletedAt == nil }, sort: \Project.sortOrder)
+    private var projects: [Project]
+    @Query(sort: \Tag.name) private var tags: [Tag]
     @Binding var selection: SidebarFilter?
     @Binding var navigationSelection: NavigationItem?
     @State private var isAddingProject = false
     @State private var newProjectName = ""
     @State private var errorMessage: String?
+    @State private var editingProject: Project?
+    @State private var editingTag: Tag?
 
     private var projectService: any ProjectServiceProtocol {
         serviceContainer!.makeProjectService(context: modelContext)
@@ -33,9 +41,18 @@ struct SidebarView: View {
                 Label("All Todos", systemImage: "tray.full")
                     .tag(NavigationItem.todos(SidebarFilter.all))
 
+                Label("Reference", systemImage: "bookmark")
+                    .tag(NavigationItem.todos(SidebarFilter.reference))
+
+                Label("Snoozed", systemImage: "moon.zzz")
+                    .tag(NavigationItem.todos(SidebarFilter.snoozed))
+
                 Label("Completed", systemImage: "checkmark.circle")
                     .tag(NavigationItem.todos(SidebarFilter.completed))
 
+                Label("Archive", systemImage: "archivebox")
+                    .tag(NavigationItem.todos(SidebarFilter.archive))
+
                 Label("Trash", systemImage: "trash")
                     .tag(NavigationItem.todos(SidebarFilter.trash))
             }
@@ -45,7 +62,10 @@ struct SidebarView: View {
                     ProjectRow(project: project)
                         .tag(NavigationItem.todos(SidebarFilter.project(project)))
                         .contextMenu {
-                            Button("Delete", role: .destructive) {
+                            Button("Edit…") {
+                                editingProject = project
+                            }
+                            Button("Move to Trash", role: .destructive) {
                                 deleteProject(project)
                             }
                         }
@@ -63,6 +83,28 @@ struct SidebarView: View {
                         }
                 }
             }
+
+            if !tags.isEmpty {
+                Section("Tags") {
+                    ForEach(tags) { tag in
+                        Label {
+                            Text(tag.name)
+                        } icon: {
+                            Image(systemName: "tag.fill")
+                                .foregroundStyle(Color(hex: tag.color) ?? .gray)
+                        }
+                        .tag(NavigationItem.todos(SidebarFilter.tag(tag)))
+                        .contextMenu {
+                            Button("Edit…") {
+                                editingTag = tag
+                            }
+                            Button("Delete", role: .destructive) {
+                                deleteTag(tag)
+                            }
+                        }
+                    }
+                }
+            }
         }
         .listStyle(.sidebar)
         .alert("Error", isPresented: .init(
@@ -73,6 +115,12 @@ struct SidebarView: View {
         } message: {
             Text(errorMessage ?? "")
         }
+        .sheet(item: $editingProject) { project in
+            ProjectEditView(project: project)
+        }
+        .sheet(item: $editingTag) { tag in
+            TagEditView(tag: tag)
+        }
         .toolbar {
             ToolbarItem {
                 Button {
@@ -106,6 +154,14 @@ struct SidebarView: View {
         newProjectName = ""
     }
 
+    private func deleteTag(_ tag: Tag) {
+        serviceContainer!.makeTagService(context: modelContext).delete(tag)
+        if case .todos(.tag(let selected)) = navigationSelection,
+           selected.id == tag.id {
+            navigationSelection = .todos(.all)
+        }
+    }
+
     private func deleteProject(_ project: Project) {
         projectService.delete(project)
         if case .todos(.project(let selected)) = navigationSelection,
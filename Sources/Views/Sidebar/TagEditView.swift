@@ -0,0 +1,90 @@
+import SwiftUI
+
+struct TagEditView: View {
+    @Environment(\.modelContext) private var modelContext
+    @Environment(\.serviceContainer) private var serviceContainer
+    @Environment(\.dismiss) private var dismiss
+    let tag: Tag
+
+    @State private var name = ""
+    @State private var colorHex = ""
+    @State private var errorMessage: String?
+
+    private var colorBinding: Binding<Color> {
+        Binding(
+            get: { Color(hex: colorHex) ?? .gray },
+            set: { newValue in
+                if let hex = newValue.hexString {
+                    colorHex = hex
+                }
+            }
+        )
+    }
+
+    var body: some View {
+        VStack(alignment: .leading, spacing: 16) {
+            HStack {
+                Text("Edit Tag")
+                    .font(.headline)
+                Spacer()
+                Text(name.isEmpty ? tag.name : name)
+                    .font(.caption)
+                    .padding(.horizontal, 8)
+                    .padding(.vertical, 2)
+                    .background(Color(hex: colorHex) ?? .gray, in: Capsule())
+                    .foregroundStyle(Color.readableText(onHex: colorHex))
+            }
+
+            Form {
+                TextField("Name", text: $name)
+
+                HStack {
+                    TextField("Color", text: $colorHex)
+                        .font(.system(.body, design: .monospaced))
+                    ColorPicker("", selection: colorBinding, supportsOpacity: false)
+                        .labelsHidden()
+                }
+                if !colorHex.isValidHexColor {
+                    Text("Use a hex color like #8E8E93")
+                        .font(.caption)
+                        .foregroundStyle(.red)
+                }
+            }
+
+            HStack {
+                Spacer()
+                Button("Cancel", role: .cancel) { dismiss() }
+                    .keyboardShortcut(.cancelAction)
+                Button("Save") { save() }
+                    .buttonStyle(.borderedProminent)
+                    .keyboardShortcut(.defaultAction)
+                    .disabled(!colorHex.isValidHexColor || name.trimmingCharacters(in: .whitespaces).isEmpty)
+            }
+        }
+        .padding(20)
+        .frame(width: 320)
+        .onAppear {
+            name = tag.name
+            colorHex = tag.color
+        }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+    }
+
+    private func save() {
+        let tagService = serviceContainer!.makeTagService(context: modelContext)
+        let hex = colorHex.trimmingCharacters(in: .whitespaces).uppercased()
+        do {
+            try tagService.update(tag, name: name, color: hex.hasPrefix("#") ? hex : "#" + hex)
+            dismiss()
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+}
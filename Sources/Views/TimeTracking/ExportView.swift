@@ -14,6 +14,9 @@ struct ExportView: View {
     @State private var exportRecordID: PersistentIdentifier?
     @State private var isCopied = false
     @State private var errorMessage: String?
+    @State private var worklogDrafts: [WorklogDraft]?
+    @State private var pendingWrite: JiraWriteRequest?
+    @State private var isSubmittingWorklogs = false
 
     var body: some View {
         VStack(spacing: 0) {
@@ -43,6 +46,25 @@ struct ExportView: View {
         } message: {
             Text(errorMessage ?? "")
         }
+        .sheet(isPresented: .init(
+            get: { worklogDrafts != nil },
+            set: { if !$0 { worklogDrafts = nil } }
+        )) {
+            WorklogPreviewView(
+                drafts: worklogDrafts ?? [],
+                isSubmitting: isSubmittingWorklogs,
+                onConfirm: {
+                    let count = worklogDrafts?.count ?? 0
+                    pendingWrite = JiraWriteRequest.make(
+                        "\(count) worklog\(count == 1 ? "" : "s") will be added to Jira."
+                    ) {
+                        submitWorklogs()
+                    }
+                },
+                onCancel: { worklogDrafts = nil }
+            )
+            .jiraWriteConsent($pendingWrite)
+        }
     }
 
     private var header: some View {
@@ -74,6 +96,15 @@ struct ExportView: View {
             .buttonStyle(.borderedProminent)
             .controlSize(.small)
             .disabled(isGenerating)
+
+            Button {
+                previewWorklogs()
+            } label: {
+                Label("Log to Jira", systemImage: "clock.arrow.circlepath")
+            }
+            .buttonStyle(.bordered)
+            .controlSize(.small)
+            .disabled(isGenerating || serviceContainer?.jiraService == nil)
         }
         .padding()
     }
@@ -209,6 +240,75 @@ struct ExportView: View {
         }
     }
 
+    private func previewWorklogs() {
+        let service = serviceContainer!.makeExportService()
+        Task {
+            do {
+                let drafts = try await service.generateWorklogs(
+                    for: selectedDate,
+                    roundingMinutes: AppConfig.worklogRoundingMinutes
+                )
+                await MainActor.run {
+                    worklogDrafts = drafts
+                }
+            } catch {
+                await MainActor.run {
+                    errorMessage = error.localizedDescription
+                }
+            }
+        }
+    }
+
+    private func submitWorklogs() {
+        guard let drafts = worklogDrafts,
+              let jiraService = serviceContainer?.jiraService else { return }
+        let service = serviceContainer!.makeExportService()
+        isSubmittingWorklogs = true
+        Task {
+            var failures: [String] = []
+            var bookingFailures: [String] = []
+            for draft in drafts {
+                do {
+                    try await jiraService.addWorklog(
+                        ticketID: draft.ticketID,
+                        started: draft.started,
+                        timeSpent: draft.roundedDuration,
+                        comment: ""
+                    )
+                } catch {
+                    failures.append("\(draft.ticketID): \(error.localizedDescription)")
+                    continue
+                }
+                // Jira has the worklog now; book its entries right away so a
+                // retry can't post them a second time
+                do {
+                    try await service.markBooked(entryIDs: draft.entryIDs)
+                } catch {
+                    bookingFailures.append("\(draft.ticketID): \(error.localizedDescription)")
+                }
+            }
+
+            var messages: [String] = []
+            if !failures.isEmpty {
+                messages.append("Some worklogs failed:\n" + failures.joined(separator: "\n"))
+            }
+            if !bookingFailures.isEmpty {
+                messages.append(
+                    "Logged to Jira, but marking the entries as booked failed."
+                        + " Don't log them again:\n" + bookingFailures.joined(separator: "\n")
+                )
+            }
+
+            await MainActor.run {
+                isSubmittingWorklogs = false
+                worklogDrafts = nil
+                if !messages.isEmpty {
+                    errorMessage = messages.joined(separator: "\n\n")
+                }
+            }
+        }
+    }
+
     private func copyToClipboard(_ text: String) {
         NSPasteboard.general.clearContents()
         NSPasteboard.general.setString(text, forType: .string)
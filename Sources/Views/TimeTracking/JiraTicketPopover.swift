@@ -110,6 +110,32 @@ struct JiraHoverModifier: ViewModifier {
                         .font(.caption)
                         .foregroundStyle(.secondary)
                 }
+                if let dueDate = info.dueDate {
+                    let isOpen = info.statusCategoryKey != "done"
+                    Label(
+                        isOpen
+                            ? Formatters.relativeDue(dueDate)
+                            : Formatters.mediumDate.string(from: dueDate),
+                        systemImage: "calendar"
+                    )
+                    .font(.caption)
+                    .foregroundStyle(
+                        isOpen && Formatters.daysUntil(dueDate) < 0 ? .red : .secondary
+                    )
+                }
+            }
+
+            if !info.labels.isEmpty {
+                HStack(spacing: 4) {
+                    ForEach(info.labels, id: \.self) { label in
+                        Text(label)
+                            .font(.caption2)
+                            .padding(.horizontal, 6)
+                            .padding(.vertical, 2)
+                            .background(.secondary.opacity(0.12))
+                            .clipShape(Capsule())
+                    }
+                }
             }
 
             if let url = info.browseURL {
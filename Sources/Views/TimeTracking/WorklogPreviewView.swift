@@ -0,0 +1,72 @@
+import SwiftUI
+
+/// Confirmation sheet listing the worklogs that will be pushed to Jira.
+struct WorklogPreviewView: View {
+    let drafts: [WorklogDraft]
+    let isSubmitting: Bool
+    let onConfirm: () -> Void
+    let onCancel: () -> Void
+
+    private var totalRounded: TimeInterval {
+        drafts.reduce(0) { $0 + $1.roundedDuration }
+    }
+
+    var body: some View {
+        VStack(spacing: 0) {
+            HStack {
+                VStack(alignment: .leading, spacing: 4) {
+                    Text("Log to Jira")
+                        .font(.headline)
+                    Text("\(drafts.count) tickets, \(totalRounded.hoursMinutes) total")
+                        .font(.caption)
+                        .foregroundStyle(.secondary)
+                }
+                Spacer()
+            }
+            .padding()
+
+            Divider()
+
+            if drafts.isEmpty {
+                Text("No reviewed entries with a Jira ticket for this day")
+                    .foregroundStyle(.secondary)
+                    .frame(maxWidth: .infinity, maxHeight: .infinity)
+            } else {
+                List(drafts) { draft in
+                    HStack {
+                        Text(draft.ticketID)
+                            .font(.system(.body, design: .monospaced))
+                        Spacer()
+                        Text(draft.duration.hoursMinutes)
+                            .foregroundStyle(.secondary)
+                            .monospacedDigit()
+                        Image(systemName: "arrow.right")
+                            .font(.caption)
+                            .foregroundStyle(.tertiary)
+                        Text(draft.roundedDuration.hoursMinutes)
+                            .fontWeight(.medium)
+                            .monospacedDigit()
+                    }
+                }
+            }
+
+            Divider()
+
+            HStack {
+                if isSubmitting {
+                    ProgressView()
+                        .controlSize(.small)
+                }
+                Spacer()
+                Button("Cancel", role: .cancel, action: onCancel)
+                    .keyboardShortcut(.cancelAction)
+                Button("Log Work", action: onConfirm)
+                    .buttonStyle(.borderedProminent)
+                    .keyboardShortcut(.defaultAction)
+                    .disabled(drafts.isEmpty || isSubmitting)
+            }
+            .padding()
+        }
+        .frame(minWidth: 420, minHeight: 320)
+    }
+}
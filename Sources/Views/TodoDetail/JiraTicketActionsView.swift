@@ -0,0 +1,96 @@
+import SwiftUI
+
+/// Watch and vote state of the linked Jira ticket, with buttons to change them.
+struct JiraTicketActionsView: View {
+    @Environment(\.serviceContainer) private var serviceContainer
+    let ticketID: String
+
+    @State private var info: JiraTicketInfo?
+    @State private var isUpdating = false
+    @State private var errorMessage: String?
+    @State private var pendingWrite: JiraWriteRequest?
+
+    var body: some View {
+        HStack(spacing: 12) {
+            Text("Jira")
+                .foregroundStyle(.secondary)
+                .frame(width: 80, alignment: .leading)
+            Text(ticketID)
+                .font(.system(.body, design: .monospaced))
+
+            if let info {
+                Button {
+                    request(
+                        info.isWatching
+                            ? "You will stop watching \(ticketID)."
+                            : "You will be added as a watcher of \(ticketID)."
+                    ) { service in
+                        try await service.setWatching(!info.isWatching, ticketID: ticketID)
+                    }
+                } label: {
+                    Label(
+                        "\(info.watchCount)",
+                        systemImage: info.isWatching ? "eye.fill" : "eye"
+                    )
+                }
+                .help(info.isWatching ? "Stop Watching" : "Watch")
+
+                Button {
+                    request(
+                        info.hasVoted
+                            ? "Your vote on \(ticketID) will be removed."
+                            : "Your vote will be added to \(ticketID)."
+                    ) { service in
+                        try await service.setVoted(!info.hasVoted, ticketID: ticketID)
+                    }
+                } label: {
+                    Label(
+                        "\(info.votes)",
+                        systemImage: info.hasVoted ? "hand.thumbsup.fill" : "hand.thumbsup"
+                    )
+                }
+                .help(info.hasVoted ? "Remove Vote" : "Vote")
+            }
+
+            if isUpdating {
+                ProgressView()
+                    .controlSize(.small)
+            }
+        }
+        .buttonStyle(.borderless)
+        .disabled(isUpdating)
+        .jiraWriteConsent($pendingWrite)
+        .task(id: ticketID) { await load() }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+    }
+
+    private func load() async {
+        info = await serviceContainer?.jiraService?.ticketInfo(for: ticketID)
+    }
+
+    private func request(
+        _ summary: String,
+        action: @escaping (any JiraServiceProtocol) async throws -> Void
+    ) {
+        pendingWrite = JiraWriteRequest.make(summary) {
+            Task {
+                guard let service = serviceContainer?.jiraService else { return }
+                isUpdating = true
+                defer { isUpdating = false }
+                do {
+                    try await action(service)
+                    await load()
+                } catch {
+                    errorMessage = error.localizedDescription
+                }
+            }
+        }
+    }
+}
@@ -0,0 +1,194 @@
+import SwiftUI
+
+/// Shows the worklogs already on the linked Jira ticket and logs new work,
+/// prefilled from tracked time on this todo that hasn't been booked yet.
+struct LogWorkView: View {
+    @Environment(\.serviceContainer) private var serviceContainer
+    @Environment(\.dismiss) private var dismiss
+    let todo: Todo
+    let ticketID: String
+
+    @State private var worklogs: [JiraWorklog]?
+    @State private var minutes = 30
+    @State private var started = Date()
+    @State private var comment = ""
+    @State private var includesTrackedTime = true
+    @State private var isLogging = false
+    // Set once Jira accepted the worklog, so a retry can't post it twice
+    @State private var hasLogged = false
+    @State private var errorMessage: String?
+    @State private var pendingWrite: JiraWriteRequest?
+
+    private var unbookedEntries: [TimeEntry] {
+        todo.timeEntries.filter {
+            !$0.isInProgress && !$0.isExcluded
+                && $0.bookingStatus != .booked && $0.bookingStatus != .exported
+        }
+    }
+
+    private var unbookedDuration: TimeInterval {
+        unbookedEntries.reduce(0) { $0 + $1.duration }
+    }
+
+    var body: some View {
+        VStack(alignment: .leading, spacing: 16) {
+            Text("Log Work on \(ticketID)")
+                .font(.headline)
+
+            existingWorklogs
+
+            Form {
+                Stepper(
+                    "Time spent: \(TimeInterval(minutes * 60).hoursMinutes)",
+                    value: $minutes,
+                    in: 1...(24 * 60),
+                    step: AppConfig.worklogRoundingMinutes
+                )
+                DatePicker("Started", selection: $started)
+                TextField("Comment", text: $comment, axis: .vertical)
+                    .lineLimit(2...4)
+                if !unbookedEntries.isEmpty {
+                    Toggle(
+                        "Mark \(unbookedDuration.hoursMinutes) of tracked time as booked",
+                        isOn: $includesTrackedTime
+                    )
+                }
+            }
+
+            HStack {
+                if isLogging {
+                    ProgressView()
+                        .controlSize(.small)
+                }
+                Spacer()
+                Button(hasLogged ? "Done" : "Cancel", role: .cancel) { dismiss() }
+                    .keyboardShortcut(.cancelAction)
+                Button("Log Work") {
+                    let spent = TimeInterval(minutes * 60)
+                    pendingWrite = JiraWriteRequest.make(
+                        "\(spent.hoursMinutes) will be logged to \(ticketID)."
+                    ) {
+                        Task { await logWork() }
+                    }
+                }
+                .buttonStyle(.borderedProminent)
+                .keyboardShortcut(.defaultAction)
+                .disabled(isLogging || hasLogged)
+            }
+        }
+        .padding(20)
+        .frame(width: 420)
+        .jiraWriteConsent($pendingWrite)
+        .onAppear { prefill() }
+        .task { await loadWorklogs() }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+    }
+
+    @ViewBuilder
+    private var existingWorklogs: some View {
+        if let worklogs {
+            if worklogs.isEmpty {
+                Text("No work logged yet")
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+            } else {
+                VStack(alignment: .leading, spacing: 6) {
+                    let total = worklogs.reduce(0) { $0 + $1.timeSpent }
+                    Text("\(total.hoursMinutes) logged")
+                        .font(.caption.bold())
+                        .foregroundStyle(.secondary)
+                    ScrollView {
+                        VStack(alignment: .leading, spacing: 6) {
+                            ForEach(worklogs) { worklog in
+                                worklogRow(worklog)
+                            }
+                        }
+                    }
+                    .frame(maxHeight: 140)
+                }
+            }
+        } else {
+            ProgressView()
+                .controlSize(.small)
+        }
+    }
+
+    private func worklogRow(_ worklog: JiraWorklog) -> some View {
+        VStack(alignment: .leading, spacing: 2) {
+            HStack {
+                Text(worklog.author)
+                    .font(.callout)
+                Spacer()
+                Text(worklog.timeSpent.hoursMinutes)
+                    .font(.system(.callout, design: .monospaced))
+            }
+            Text(Formatters.mediumDate.string(from: worklog.started))
+                .font(.caption)
+                .foregroundStyle(.secondary)
+            if !worklog.comment.isEmpty {
+                Text(worklog.comment)
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+                    .lineLimit(2)
+            }
+        }
+    }
+
+    private func prefill() {
+        let entries = unbookedEntries
+        guard !entries.isEmpty else { return }
+        let increment = max(AppConfig.worklogRoundingMinutes, 1)
+        let tracked = Int((unbookedDuration / 60).rounded(.up))
+        minutes = max(increment, Int((Double(tracked) / Double(increment)).rounded(.up)) * increment)
+        started = entries.map(\.startTime).min() ?? started
+    }
+
+    private func loadWorklogs() async {
+        guard let jiraService = serviceContainer?.jiraService else { return }
+        do {
+            worklogs = try await jiraService.worklogs(for: ticketID)
+        } catch {
+            worklogs = []
+            errorMessage = error.localizedDescription
+        }
+    }
+
+    private func logWork() async {
+        guard let jiraService = serviceContainer?.jiraService else { return }
+        isLogging = true
+        defer { isLogging = false }
+        let trackedIDs = includesTrackedTime ? unbookedEntries.map(\.id) : []
+        do {
+            try await jiraService.addWorklog(
+                ticketID: ticketID,
+                started: started,
+                timeSpent: TimeInterval(minutes * 60),
+                comment: comment.trimmingCharacters(in: .whitespacesAndNewlines)
+            )
+        } catch {
+            errorMessage = error.localizedDescription
+            return
+        }
+        hasLogged = true
+
+        if !trackedIDs.isEmpty {
+            do {
+                let service = serviceContainer!.makeExportService()
+                try await service.markBooked(entryIDs: trackedIDs)
+            } catch {
+                includesTrackedTime = false
+                errorMessage = "The work was logged to \(ticketID), but marking the tracked time"
+                    + " as booked failed: \(error.localizedDescription)"
+                return
+            }
+        }
+        dismiss()
+    }
+}
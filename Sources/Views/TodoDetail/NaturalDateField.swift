@@ -0,0 +1,38 @@
+import SwiftUI
+
+/// Text field that accepts phrases like "tomorrow", "next fri" or "in 3 days",
+/// previews the parsed date and hands it back on Return.
+struct NaturalDateField: View {
+    var prompt = "e.g. next fri, in 3 days"
+    let onCommit: (Date) -> Void
+
+    @State private var text = ""
+
+    private var parsed: Date? {
+        QuickAddParser.parseDueDate(text)
+    }
+
+    var body: some View {
+        HStack(spacing: 6) {
+            TextField("", text: $text, prompt: Text(prompt))
+                .textFieldStyle(.roundedBorder)
+                .frame(width: 150)
+                .onSubmit {
+                    guard let parsed else { return }
+                    onCommit(parsed)
+                    text = ""
+                }
+
+            if !text.isEmpty {
+                if let parsed {
+                    Text(Formatters.mediumDate.string(from: parsed))
+                        .foregroundStyle(.secondary)
+                } else {
+                    Text("Not a date")
+                        .foregroundStyle(.red)
+                }
+            }
+        }
+        .font(.caption)
+    }
+}
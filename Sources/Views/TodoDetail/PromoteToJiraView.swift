@@ -0,0 +1,134 @@
+import SwiftUI
+
+struct PromoteToJiraView: View {
+    @Environment(\.modelContext) private var modelContext
+    @Environment(\.serviceContainer) private var serviceContainer
+    @Environment(\.dismiss) private var dismiss
+    let todo: Todo
+
+    @AppStorage(AppConfig.Keys.jiraPromoteProjectKey)
+    private var projectKey = ""
+    @AppStorage(AppConfig.Keys.jiraPromoteIssueType)
+    private var issueType = "Task"
+    @AppStorage(AppConfig.Keys.jiraPromoteSendsPriority)
+    private var sendsPriority = true
+    @State private var summary = ""
+    @State private var priority: Priority = .medium
+    @State private var completesTodo = false
+    @State private var isCreating = false
+    @State private var errorMessage: String?
+    @State private var pendingWrite: JiraWriteRequest?
+
+    static let issueTypes = ["Task", "Story", "Bug", "Sub-task", "Epic"]
+
+    private var canCreate: Bool {
+        !isCreating
+            && !projectKey.trimmingCharacters(in: .whitespaces).isEmpty
+            && !issueType.trimmingCharacters(in: .whitespaces).isEmpty
+            && !summary.trimmingCharacters(in: .whitespaces).isEmpty
+    }
+
+    var body: some View {
+        VStack(alignment: .leading, spacing: 16) {
+            Text("Promote to Jira")
+                .font(.headline)
+
+            Form {
+                TextField("Project key", text: $projectKey, prompt: Text("PROJ"))
+                    .font(.system(.body, design: .monospaced))
+                HStack {
+                    TextField("Issue type", text: $issueType)
+                    Menu {
+                        ForEach(Self.issueTypes, id: \.self) { type in
+                            Button(type) { issueType = type }
+                        }
+                    } label: {
+                        Image(systemName: "chevron.down")
+                    }
+                    .menuStyle(.borderlessButton)
+                    .fixedSize()
+                }
+                TextField("Summary", text: $summary)
+                HStack {
+                    Toggle("Priority", isOn: $sendsPriority)
+                    Picker("Priority", selection: $priority) {
+                        ForEach(Priority.allCases) { option in
+                            Text(option.jiraName).tag(option)
+                        }
+                    }
+                    .labelsHidden()
+                    .disabled(!sendsPriority)
+                }
+                Toggle("Complete this todo after creating", isOn: $completesTodo)
+            }
+
+            Text(
+                todo.descriptionText.isEmpty
+                    ? "Turn off Priority if the Jira project hides that field."
+                    : "Notes are sent as the issue description. Turn off Priority if the Jira project hides that field."
+            )
+            .font(.caption)
+            .foregroundStyle(.tertiary)
+
+            HStack {
+                if isCreating {
+                    ProgressView()
+                        .controlSize(.small)
+                }
+                Spacer()
+                Button("Cancel", role: .cancel) { dismiss() }
+                    .keyboardShortcut(.cancelAction)
+                Button("Create Issue") {
+                    pendingWrite = JiraWriteRequest.make(
+                        "A new \(issueType) will be created in \(projectKey.uppercased())."
+                    ) {
+                        Task { await create() }
+                    }
+                }
+                .buttonStyle(.borderedProminent)
+                .keyboardShortcut(.defaultAction)
+                .disabled(!canCreate)
+            }
+        }
+        .padding(20)
+        .frame(width: 380)
+        .jiraWriteConsent($pendingWrite)
+        .onAppear {
+            summary = todo.title
+            priority = todo.priority
+        }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+    }
+
+    private func create() async {
+        guard let jiraService = serviceContainer?.jiraService else { return }
+        isCreating = true
+        defer { isCreating = false }
+        let key = projectKey.trimmingCharacters(in: .whitespaces).uppercased()
+        projectKey = key
+        do {
+            let issue = try await jiraService.createIssue(
+                projectKey: key,
+                issueType: issueType.trimmingCharacters(in: .whitespaces),
+                summary: summary.trimmingCharacters(in: .whitespaces),
+                description: todo.descriptionText,
+                priority: sendsPriority ? priority.jiraName : nil
+            )
+            let todoService = serviceContainer!.makeTodoService(context: modelContext)
+            todoService.linkJira(todo, ticketID: issue.key, serverURL: issue.serverURL)
+            if completesTodo {
+                todoService.complete(todo)
+            }
+            dismiss()
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+}
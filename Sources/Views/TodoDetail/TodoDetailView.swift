@@ -1,15 +1,32 @@
 import SwiftUI
 import SwiftData
+import AppKit
 
 struct TodoDetailView: View {
     @Environment(\.modelContext) private var modelContext
     @Environment(\.serviceContainer) private var serviceContainer
+    @Environment(PomodoroService.self) private var pomodoro
     @Bindable var todo: Todo
-    @Query(sort: \Project.sortOrder) private var allProjects: [Project]
+    @Query(filter: #Predicate<Project> { $0.deletedAt == nil }, sort: \Project.sortOrder)
+    private var allProjects: [Project]
     @Query(sort: \Tag.name) private var allTags: [Tag]
+    @Query(
+        filter: #Predicate<Todo> { $0.deletedAt == nil && $0.isCompleted == false },
+        sort: \Todo.title
+    )
+    private var openTodos: [Todo]
 
     @State private var isEditingTitle = false
     @State private var editedTitle = ""
+    @State private var isPickingSnooze = false
+    @State private var isPromotingToJira = false
+    @State private var isLoggingWork = false
+    @State private var showBlockedWarning = false
+    @State private var errorMessage: String?
+    @State private var showPermanentDeleteConfirmation = false
+    @State private var customSnoozeDate = Date().addingTimeInterval(3600)
+    @State private var summary: [String] = []
+    @State private var summaryTodoID: UUID?
 
     private var todoService: any TodoServiceProtocol {
         serviceContainer!.makeTodoService(context: modelContext)
@@ -32,9 +49,19 @@ struct TodoDetailView: View {
                     } label: {
                         Label("Restore", systemImage: "arrow.uturn.backward")
                     }
+
+                    Button(role: .destructive) {
+                        showPermanentDeleteConfirmation = true
+                    } label: {
+                        Label("Delete Permanently", systemImage: "trash.slash")
+                    }
                 } else {
                     Button {
-                        todoService.toggleComplete(todo)
+                        if todo.needsCompletionConfirmation {
+                            showBlockedWarning = true
+                        } else {
+                            todoService.toggleComplete(todo)
+                        }
                     } label: {
                         Label(
                             todo.isCompleted ? "Reopen" : "Complete",
@@ -43,6 +70,70 @@ struct TodoDetailView: View {
                     }
                     .keyboardShortcut(.return, modifiers: .command)
 
+                    if todo.isCompleted || todo.isArchived {
+                        Button {
+                            if todo.isArchived {
+                                todoService.unarchive(todo)
+                            } else {
+                                todoService.archive(todo)
+                            }
+                        } label: {
+                            Label(
+                                todo.isArchived ? "Unarchive" : "Archive",
+                                systemImage: todo.isArchived ? "tray.and.arrow.up" : "archivebox"
+                            )
+                        }
+                    }
+
+                    Button {
+                        todoService.setKind(
+                            todo, kind: todo.isReference ? .task : .reference
+                        )
+                    } label: {
+                        Label(
+                            todo.isReference ? "Convert to Todo" : "Keep as Reference",
+                            systemImage: todo.isReference ? "checklist" : "bookmark"
+                        )
+                    }
+
+                    if todo.isActive && !todo.isReference {
+                        Button {
+                            if pomodoro.isRunning(for: todo) {
+                                pomodoro.stop()
+                            } else {
+                                pomodoro.start(todo: todo)
+                            }
+                        } label: {
+                            Label(
+                                pomodoro.isRunning(for: todo) ? "Stop Pomodoro" : "Start Pomodoro",
+                                systemImage: pomodoro.isRunning(for: todo) ? "stop.circle" : "timer"
+                            )
+                        }
+                    }
+
+                    if !todo.isCompleted {
+                        SnoozeMenu(todo: todo) {
+                            customSnoozeDate = todo.snoozedUntil ?? Date().addingTimeInterval(3600)
+                            isPickingSnooze = true
+                        }
+                    }
+
+                    if todo.jiraLink == nil, serviceContainer?.jiraService != nil {
+                        Button {
+                            isPromotingToJira = true
+                        } label: {
+                            Label("Promote to Jira", systemImage: "arrow.up.forward.app")
+                        }
+                    }
+
+                    if todo.jiraLink != nil, serviceContainer?.jiraService != nil {
+                        Button {
+                            isLoggingWork = true
+                        } label: {
+                            Label("Log Work", systemImage: "clock.badge.checkmark")
+                        }
+                    }
+
                     Button {
                         todoService.softDelete(todo)
                     } label: {
@@ -50,8 +141,85 @@ struct TodoDetailView: View {
                     }
                     .keyboardShortcut(.delete, modifiers: .command)
                 }
+
+                if let url = todo.browseURL {
+                    Button {
+                        NSWorkspace.shared.open(url)
+                    } label: {
+                        Label("Open in Browser", systemImage: "arrow.up.right.square")
+                    }
+                    .keyboardShortcut("o", modifiers: [.command, .shift])
+
+                    Button {
+                        NSPasteboard.general.clearContents()
+                        NSPasteboard.general.setString(url.absoluteString, forType: .string)
+                    } label: {
+                        Label("Copy Link", systemImage: "link")
+                    }
+                    .keyboardShortcut("c", modifiers: [.command, .shift])
+                }
+
+                Menu {
+                    TodoLinkMenu(todo: todo)
+                } label: {
+                    Label("Share", systemImage: "square.and.arrow.up")
+                }
+            }
+        }
+        .sheet(isPresented: $isPickingSnooze) {
+            customSnoozeSheet
+        }
+        .sheet(isPresented: $isPromotingToJira) {
+            PromoteToJiraView(todo: todo)
+        }
+        .sheet(isPresented: $isLoggingWork) {
+            if let ticketID = todo.jiraLink?.ticketID {
+                LogWorkView(todo: todo, ticketID: ticketID)
+            }
+        }
+        .blockedCompletionDialog(for: todo, isPresented: $showBlockedWarning) {
+            todoService.complete(todo)
+        }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+        .permanentDeleteConfirmation(todo.title, isPresented: $showPermanentDeleteConfirmation) {
+            todoService.permanentlyDelete(todo)
+        }
+    }
+
+    private var customSnoozeSheet: some View {
+        VStack(alignment: .leading, spacing: 16) {
+            Text("Snooze Until")
+                .font(.headline)
+            DatePicker(
+                "Snooze until",
+                selection: $customSnoozeDate,
+                in: Date()...,
+                displayedComponents: [.date, .hourAndMinute]
+            )
+            .labelsHidden()
+            HStack {
+                Spacer()
+                Button("Cancel", role: .cancel) {
+                    isPickingSnooze = false
+                }
+                .keyboardShortcut(.cancelAction)
+                Button("Snooze") {
+                    todoService.snooze(todo, until: customSnoozeDate)
+                    isPickingSnooze = false
+                }
+                .buttonStyle(.borderedProminent)
+                .keyboardShortcut(.defaultAction)
             }
         }
+        .padding(20)
+        .frame(minWidth: 300)
     }
 
     @ViewBuilder
@@ -124,54 +292,110 @@ struct TodoDetailView: View {
                 )) {
                     Text("None").tag(Project?.none)
                     ForEach(allProjects) { project in
-                        HStack {
-                            Circle()
-                                .fill(Color(hex: project.color) ?? .blue)
-                                .frame(width: 8, height: 8)
-                            Text(project.name)
-                        }
-                        .tag(Optional(project))
+                        ProjectChip(project: project)
+                            .tag(Optional(project))
                     }
                 }
                 .labelsHidden()
                 .frame(width: 160)
             }
 
-            // Due Date
-            HStack {
-                Text("Due Date")
-                    .foregroundStyle(.secondary)
-                    .frame(width: 80, alignment: .leading)
-                if let dueDate = Binding(
-                    get: { todo.dueDate },
-                    set: { newValue in
-                        todoService.update(todo, dueDate: newValue)
+            // Start & Due Date
+            if !todo.isReference {
+                HStack {
+                    Text("Start Date")
+                        .foregroundStyle(.secondary)
+                        .frame(width: 80, alignment: .leading)
+                    if let startDate = todo.startDate {
+                        DatePicker("", selection: Binding(
+                            get: { startDate },
+                            set: { newValue in
+                                todoService.update(todo, startDate: newValue)
+                            }
+                        ), displayedComponents: .date)
+                        .labelsHidden()
+
+                        Button {
+                            todoService.update(todo, startDate: Optional<Date>.none)
+                        } label: {
+                            Image(systemName: "xmark.circle.fill")
+                                .foregroundStyle(.secondary)
+                        }
+                        .buttonStyle(.plain)
+                    } else {
+                        Button("Set Start Date") {
+                            todoService.update(
+                                todo, startDate: Calendar.current.startOfDay(for: Date())
+                            )
+                        }
                     }
-                ).wrappedValue {
-                    DatePicker("", selection: Binding(
-                        get: { dueDate },
+                }
+
+                HStack {
+                    Text("Due Date")
+                        .foregroundStyle(.secondary)
+                        .frame(width: 80, alignment: .leading)
+                    if let dueDate = Binding(
+                        get: { todo.dueDate },
                         set: { newValue in
                             todoService.update(todo, dueDate: newValue)
                         }
-                    ), displayedComponents: .date)
-                    .labelsHidden()
+                    ).wrappedValue {
+                        DatePicker("", selection: Binding(
+                            get: { dueDate },
+                            set: { newValue in
+                                todoService.update(todo, dueDate: newValue)
+                            }
+                        ), displayedComponents: .date)
+                        .labelsHidden()
 
-                    Button {
-                        todoService.update(todo, dueDate: Optional<Date>.none)
-                    } label: {
-                        Image(systemName: "xmark.circle.fill")
-                            .foregroundStyle(.secondary)
+                        Button {
+                            todoService.update(todo, dueDate: Optional<Date>.none)
+                        } label: {
+                            Image(systemName: "xmark.circle.fill")
+                                .foregroundStyle(.secondary)
+                        }
+                        .buttonStyle(.plain)
+                    } else {
+                        Button("Set Due Date") {
+                            todoService.update(todo, dueDate: Calendar.current.date(
+                                byAdding: .day, value: 1, to: Date()
+                            ))
+                        }
                     }
-                    .buttonStyle(.plain)
-                } else {
-                    Button("Set Due Date") {
-                        todoService.update(todo, dueDate: Calendar.current.date(
-                            byAdding: .day, value: 1, to: Date()
-                        ))
+
+                    NaturalDateField { date in
+                        todoService.update(todo, dueDate: date)
+                    }
+                }
+
+                if todo.dueDate != nil {
+                    HStack {
+                        Text("Remind")
+                            .foregroundStyle(.secondary)
+                            .frame(width: 80, alignment: .leading)
+                        Picker("", selection: Binding(
+                            get: { todo.reminderLeadDays },
+                            set: { newValue in
+                                todoService.update(todo, reminderLeadDays: .some(newValue))
+                            }
+                        )) {
+                            Text("Default").tag([Int]?.none)
+                            Text("None").tag([Int]?.some([]))
+                            ForEach(ReminderLeadTime.allCases) { lead in
+                                Text(lead.label).tag([Int]?.some([lead.rawValue]))
+                            }
+                        }
+                        .labelsHidden()
+                        .frame(width: 160)
                     }
                 }
             }
 
+            if let ticketID = todo.jiraLink?.ticketID, serviceContainer?.jiraService != nil {
+                JiraTicketActionsView(ticketID: ticketID)
+            }
+
             // Tags
             VStack(alignment: .leading, spacing: 6) {
                 Text("Tags")
@@ -179,23 +403,10 @@ struct TodoDetailView: View {
 
                 FlowLayout(spacing: 6) {
                     ForEach(todo.tags) { tag in
-                        HStack(spacing: 4) {
-                            Circle()
-                                .fill(Color(hex: tag.color) ?? .gray)
-                                .frame(width: 6, height: 6)
-                            Text(tag.name)
-                                .font(.caption)
-                            Button {
-                                todo.tags.removeAll { $0.id == tag.id }
-                            } label: {
-                                Image(systemName: "xmark")
-                                    .font(.caption2)
-                            }
-                            .buttonStyle(.plain)
+                        TagChip(tag: tag) {
+                            todo.tags.removeAll { $0.id == tag.id }
                         }
-                        .padding(.horizontal, 8)
-                        .padding(.vertical, 4)
-                        .background(.quaternary, in: Capsule())
+                        .font(.caption)
                     }
 
                     Menu {
@@ -220,17 +431,97 @@ struct TodoDetailView: View {
                     .menuStyle(.borderlessButton)
                 }
             }
+
+            dependenciesSection
         }
 
         Divider()
     }
 
+    @ViewBuilder
+    private var dependenciesSection: some View {
+        VStack(alignment: .leading, spacing: 6) {
+            Text("Blocked By")
+                .foregroundStyle(.secondary)
+
+            ForEach(todo.blockers) { blocker in
+                HStack(spacing: 6) {
+                    Image(systemName: blocker.isCompleted ? "checkmark.circle.fill" : "lock.fill")
+                        .foregroundStyle(blocker.isCompleted ? .green : .orange)
+                    Text(blocker.title)
+                        .strikethrough(blocker.isCompleted)
+                        .lineLimit(1)
+                    Button {
+                        todoService.removeBlocker(blocker, from: todo)
+                    } label: {
+                        Image(systemName: "xmark.circle.fill")
+                            .foregroundStyle(.secondary)
+                    }
+                    .buttonStyle(.plain)
+                }
+                .font(.callout)
+            }
+
+            Menu {
+                let candidates = openTodos.filter { candidate in
+                    candidate.id != todo.id
+                        && !todo.blockers.contains { $0.id == candidate.id }
+                }
+                if candidates.isEmpty {
+                    Text("No open todos")
+                } else {
+                    ForEach(candidates) { candidate in
+                        Button(candidate.title) {
+                            do {
+                                try todoService.addBlocker(candidate, to: todo)
+                            } catch {
+                                errorMessage = error.localizedDescription
+                            }
+                        }
+                    }
+                }
+            } label: {
+                Label("Add Blocker", systemImage: "plus")
+                    .font(.caption)
+                    .padding(.horizontal, 8)
+                    .padding(.vertical, 4)
+            }
+            .menuStyle(.borderlessButton)
+            .fixedSize()
+
+            if !todo.openBlocking.isEmpty {
+                Text("Blocks: \(todo.openBlocking.map(\.title).joined(separator: ", "))")
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+            }
+        }
+    }
+
     @ViewBuilder
     private var descriptionSection: some View {
         VStack(alignment: .leading, spacing: 6) {
             Text("Notes")
                 .font(.headline)
 
+            if !summary.isEmpty {
+                VStack(alignment: .leading, spacing: 4) {
+                    Label("Summary", systemImage: "text.badge.star")
+                        .font(.caption.bold())
+                        .foregroundStyle(.secondary)
+                    ForEach(Array(summary.enumerated()), id: \.offset) { _, sentence in
+                        HStack(alignment: .top, spacing: 6) {
+                            Text("•")
+                            Text(sentence)
+                                .textSelection(.enabled)
+                        }
+                        .font(.callout)
+                    }
+                }
+                .padding(8)
+                .frame(maxWidth: .infinity, alignment: .leading)
+                .background(.blue.opacity(0.08), in: RoundedRectangle(cornerRadius: 8))
+            }
+
             TextEditor(text: Binding(
                 get: { todo.descriptionText },
                 set: { newValue in
@@ -244,6 +535,16 @@ struct TodoDetailView: View {
             .padding(8)
             .background(.quaternary, in: RoundedRectangle(cornerRadius: 8))
         }
+        .task(id: todo.descriptionText) {
+            // Wait for typing to pause before tokenizing the notes again;
+            // a newly selected todo is summarized right away
+            if summaryTodoID == todo.id {
+                try? await Task.sleep(for: .milliseconds(500))
+                guard !Task.isCancelled else { return }
+            }
+            summary = TextSummarizer.summarize(todo.descriptionText)
+            summaryTodoID = todo.id
+        }
     }
 
     private func commitTitleEdit() {
@@ -0,0 +1,52 @@
+import SwiftUI
+
+extension Todo {
+    /// Completing this todo warrants a confirmation: its blockers are still
+    /// open, or other open todos are waiting on it.
+    var needsCompletionConfirmation: Bool {
+        !isCompleted && (isBlocked || !openBlocking.isEmpty)
+    }
+}
+
+/// Asks for confirmation before completing a todo whose blockers are still
+/// open, or one that other open todos depend on.
+struct BlockedCompletionDialog: ViewModifier {
+    let todo: Todo
+    @Binding var isPresented: Bool
+    let onComplete: () -> Void
+
+    func body(content: Content) -> some View {
+        content.confirmationDialog(
+            todo.isBlocked ? "Complete Blocked Todo?" : "Complete Todo Others Depend On?",
+            isPresented: $isPresented,
+            titleVisibility: .visible
+        ) {
+            Button(todo.isBlocked ? "Complete Anyway" : "Complete") { onComplete() }
+        } message: {
+            Text(message)
+        }
+    }
+
+    private var message: String {
+        var parts: [String] = []
+        if todo.isBlocked {
+            parts.append("\"\(todo.title)\" is still waiting on: "
+                + todo.openBlockers.map(\.title).joined(separator: ", "))
+        }
+        if !todo.openBlocking.isEmpty {
+            parts.append("These todos depend on it and will no longer be blocked by it: "
+                + todo.openBlocking.map(\.title).joined(separator: ", "))
+        }
+        return parts.joined(separator: "\n\n")
+    }
+}
+
+extension View {
+    func blockedCompletionDialog(
+        for todo: Todo, isPresented: Binding<Bool>, onComplete: @escaping () -> Void
+    ) -> some View {
+        modifier(BlockedCompletionDialog(
+            todo: todo, isPresented: isPresented, onComplete: onComplete
+        ))
+    }
+}
@@ -0,0 +1,30 @@
+import SwiftUI
+
+/// Asks for confirmation before a trashed todo or project is deleted for good.
+struct PermanentDeleteConfirmation: ViewModifier {
+    let name: String
+    @Binding var isPresented: Bool
+    let onDelete: () -> Void
+
+    func body(content: Content) -> some View {
+        content.confirmationDialog(
+            "Delete Permanently?",
+            isPresented: $isPresented,
+            titleVisibility: .visible
+        ) {
+            Button("Delete", role: .destructive) { onDelete() }
+        } message: {
+            Text("\"\(name)\" will be removed for good. This cannot be undone.")
+        }
+    }
+}
+
+extension View {
+    func permanentDeleteConfirmation(
+        _ name: String, isPresented: Binding<Bool>, onDelete: @escaping () -> Void
+    ) -> some View {
+        modifier(PermanentDeleteConfirmation(
+            name: name, isPresented: isPresented, onDelete: onDelete
+        ))
+    }
+}
@@ -0,0 +1,107 @@
+import SwiftUI
+import Charts
+
+/// Gantt-style chart of a project's open todos, spanning start date to due date.
+/// Todos with only one of the two dates are drawn as a one-day bar.
+struct ProjectTimelineView: View {
+    @Environment(\.dismiss) private var dismiss
+    let project: Project
+
+    private struct Span: Identifiable {
+        let id: UUID
+        let title: String
+        let start: Date
+        let end: Date
+        let isOverdue: Bool
+    }
+
+    private var spans: [Span] {
+        let calendar = Calendar.current
+        let today = calendar.startOfDay(for: Date())
+        return project.todos
+            .filter { $0.isActive && !$0.isArchived && !$0.isReference }
+            .compactMap { todo -> Span? in
+                guard let anchor = todo.startDate ?? todo.dueDate else { return nil }
+                let start = calendar.startOfDay(for: anchor)
+                let due = calendar.startOfDay(for: todo.dueDate ?? anchor)
+                let end = calendar.date(byAdding: .day, value: 1, to: max(start, due))!
+                return Span(
+                    id: todo.id,
+                    title: todo.title,
+                    start: start,
+                    end: end,
+                    isOverdue: todo.dueDate.map { calendar.startOfDay(for: $0) < today } ?? false
+                )
+            }
+            .sorted { ($0.start, $0.end) < ($1.start, $1.end) }
+    }
+
+    var body: some View {
+        let spans = spans
+        let undated = project.todos.filter {
+            $0.isActive && !$0.isArchived && !$0.isReference
+                && $0.startDate == nil && $0.dueDate == nil
+        }.count
+
+        VStack(alignment: .leading, spacing: 12) {
+            HStack {
+                ProjectChip(project: project)
+                    .font(.headline)
+                Text("Timeline")
+                    .font(.headline)
+                    .foregroundStyle(.secondary)
+                Spacer()
+                Button("Done") { dismiss() }
+                    .keyboardShortcut(.defaultAction)
+            }
+
+            if spans.isEmpty {
+                Text("No open todos with start or due dates")
+                    .foregroundStyle(.secondary)
+                    .frame(maxWidth: .infinity, maxHeight: .infinity)
+            } else {
+                Chart(spans) { span in
+                    BarMark(
+                        xStart: .value("Start", span.start),
+                        xEnd: .value("Due", span.end),
+                        // Keyed by ID so todos sharing a title get their own rows
+                        y: .value("Todo", span.id.uuidString)
+                    )
+                    .foregroundStyle(
+                        span.isOverdue ? Color.red : Color(hex: project.color) ?? .blue
+                    )
+                    .cornerRadius(3)
+
+                    RuleMark(x: .value("Today", Date()))
+                        .foregroundStyle(.secondary)
+                        .lineStyle(StrokeStyle(lineWidth: 1, dash: [3, 3]))
+                }
+                .chartYAxis {
+                    AxisMarks { value in
+                        AxisValueLabel {
+                            if let id = value.as(String.self),
+                               let span = spans.first(where: { $0.id.uuidString == id }) {
+                                Text(span.title)
+                            }
+                        }
+                    }
+                }
+                .chartXAxis {
+                    AxisMarks(values: .stride(by: .day, count: 7)) { _ in
+                        AxisGridLine()
+                        AxisValueLabel(format: .dateTime.month(.abbreviated).day())
+                    }
+                }
+                .frame(minHeight: CGFloat(spans.count) * 28 + 40)
+            }
+
+            if undated > 0 {
+                Text("\(undated) open todos without dates are not shown.")
+                    .font(.caption)
+                    .foregroundStyle(.tertiary)
+            }
+        }
+        .padding(20)
+        .frame(minWidth: 640, minHeight: 360)
+    }
+}
@@ -0,0 +1,37 @@
+import SwiftUI
+
+/// Snooze presets shared by the todo row context menu and the detail toolbar.
+struct SnoozeMenu: View {
+    @Environment(\.modelContext) private var modelContext
+    @Environment(\.serviceContainer) private var serviceContainer
+    let todo: Todo
+    var onCustom: (() -> Void)? = nil
+
+    private var todoService: any TodoServiceProtocol {
+        serviceContainer!.makeTodoService(context: modelContext)
+    }
+
+    var body: some View {
+        Menu {
+            ForEach(SnoozeOption.allCases) { option in
+                Button(option.label) {
+                    todoService.snooze(todo, until: option.date())
+                }
+            }
+
+            if let onCustom {
+                Divider()
+                Button("Custom…", action: onCustom)
+            }
+
+            if todo.isSnoozed {
+                Divider()
+                Button("Unsnooze") {
+                    todoService.snooze(todo, until: nil)
+                }
+            }
+        } label: {
+            Label("Snooze", systemImage: "moon.zzz")
+        }
+    }
+}
@@ -0,0 +1,24 @@
+import SwiftUI
+
+/// Capsule filled with the tag color; text switches between black and white for contrast.
+struct TagChip: View {
+    let tag: Tag
+    var onRemove: (() -> Void)? = nil
+
+    var body: some View {
+        HStack(spacing: 4) {
+            Text(tag.name)
+            if let onRemove {
+                Button(action: onRemove) {
+                    Image(systemName: "xmark")
+                        .font(.caption2)
+                }
+                .buttonStyle(.plain)
+            }
+        }
+        .padding(.horizontal, onRemove == nil ? 5 : 8)
+        .padding(.vertical, onRemove == nil ? 1 : 4)
+        .background(Color(hex: tag.color) ?? .gray, in: Capsule())
+        .foregroundStyle(Color.readableText(onHex: tag.color))
+    }
+}
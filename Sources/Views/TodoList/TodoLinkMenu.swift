@@ -0,0 +1,80 @@
+import SwiftUI
+import AppKit
+import UniformTypeIdentifiers
+
+/// Open/copy actions shared by the todo row context menu and the detail toolbar.
+/// Keyboard shortcuts for these live on the detail toolbar, since this menu is
+/// built once per row.
+struct TodoLinkMenu: View {
+    @Environment(\.logService) private var logService
+    let todo: Todo
+
+    var body: some View {
+        Button {
+            if let url = todo.browseURL {
+                NSWorkspace.shared.open(url)
+            }
+        } label: {
+            Label("Open in Browser", systemImage: "arrow.up.right.square")
+        }
+        .disabled(todo.browseURL == nil)
+
+        Divider()
+
+        Button {
+            copyToClipboard(todo.title)
+        } label: {
+            Label("Copy Title", systemImage: "doc.on.doc")
+        }
+
+        if let ticketID = todo.jiraLink?.ticketID {
+            Button {
+                copyToClipboard(ticketID)
+            } label: {
+                Label("Copy Ticket ID", systemImage: "ticket")
+            }
+        }
+
+        if let url = todo.browseURL {
+            Button {
+                copyToClipboard(url.absoluteString)
+            } label: {
+                Label("Copy Link", systemImage: "link")
+            }
+        }
+
+        Divider()
+
+        Button {
+            copyToClipboard(TodoMarkdownExporter.markdown(for: todo))
+        } label: {
+            Label("Copy as Markdown", systemImage: "doc.plaintext")
+        }
+
+        Button {
+            exportMarkdown()
+        } label: {
+            Label("Export as Markdown…", systemImage: "square.and.arrow.down")
+        }
+    }
+
+    private func exportMarkdown() {
+        let panel = NSSavePanel()
+        panel.allowedContentTypes = [UTType(filenameExtension: "md") ?? .plainText]
+        panel.nameFieldStringValue = TodoMarkdownExporter.suggestedFileName(for: todo)
+        guard panel.runModal() == .OK, let url = panel.url else { return }
+        do {
+            try TodoMarkdownExporter.markdown(for: todo)
+                .write(to: url, atomically: true, encoding: .utf8)
+        } catch {
+            logService?.log("Markdown export failed: \(error)", level: .error)
+            // Menu content can't host a SwiftUI alert; the save panel is modal too
+            NSAlert(error: error).runModal()
+        }
+    }
+
+    private func copyToClipboard(_ text: String) {
+        NSPasteboard.general.clearContents()
+        NSPasteboard.general.setString(text, forType: .string)
+    }
+}
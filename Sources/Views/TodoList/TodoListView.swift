@@ -4,12 +4,29 @@ import SwiftData
 struct TodoListView: View {
     @Environment(\.modelContext) private var modelContext
     @Environment(\.serviceContainer) private var serviceContainer
+    @Query(filter: #Predicate<Project> { $0.deletedAt != nil }, sort: \Project.deletedAt, order: .reverse)
+    private var trashedProjects: [Project]
+    @Query(filter: #Predicate<Todo> { $0.deletedAt == nil && $0.isCompleted == false })
+    private var openTodos: [Todo]
     @Binding var selectedTodo: Todo?
+    @Binding var scrollAnchors: [SidebarFilter: UUID]
     let filter: SidebarFilter
+    @State private var visibleRows: Set<UUID> = []
+    @State private var displayedIDs: [UUID] = []
+    @State private var isRestoringScroll = false
     @State private var searchText = ""
     @State private var isAddingTodo = false
     @State private var newTodoTitle = ""
     @State private var errorMessage: String?
+    @State private var timelineProject: Project?
+    @State private var showEmptyTrashConfirmation = false
+    @State private var projectPendingDeletion: Project?
+    @State private var showProjectDeleteConfirmation = false
+    @State private var collapsedGroups: Set<String> = []
+    @AppStorage(AppConfig.Keys.todoGrouping)
+    private var grouping: TodoGrouping = .none
+    @AppStorage(AppConfig.Keys.todoSort)
+    private var sort: TodoSort = .manual
 
     private var todoService: any TodoServiceProtocol {
         serviceContainer!.makeTodoService(context: modelContext)
@@ -23,21 +40,42 @@ struct TodoListView: View {
 
             Divider()
 
-            let todos = filteredTodos
-            if todos.isEmpty {
+            let todos = sort.sorted(filteredTodos)
+            let projects = filter == .trash ? trashedProjects : []
+            if todos.isEmpty && projects.isEmpty {
                 emptyState
             } else {
-                List(selection: $selectedTodo) {
-                    if isAddingTodo {
-                        newTodoField
-                    }
+                ScrollViewReader { proxy in
+                    List(selection: $selectedTodo) {
+                        if isAddingTodo {
+                            newTodoField
+                        }
+
+                        ForEach(projects) { project in
+                            trashedProjectRow(project)
+                        }
 
-                    ForEach(todos) { todo in
-                        TodoRow(todo: todo)
-                            .tag(todo)
+                        if grouping == .none {
+                            todoRows(todos)
+                        } else {
+                            ForEach(grouping.groups(todos)) { group in
+                                Section {
+                                    if !collapsedGroups.contains(group.id) {
+                                        todoRows(group.todos)
+                                    }
+                                } header: {
+                                    groupHeader(group)
+                                }
+                            }
+                        }
                     }
+                    .listStyle(.inset)
+                    .onAppear { restoreScroll(proxy) }
+                    .onChange(of: filter) { restoreScroll(proxy) }
+                    .onChange(of: visibleRows) { rememberScroll() }
                 }
-                .listStyle(.inset)
+                .onAppear { displayedIDs = todos.map(\.id) }
+                .onChange(of: todos.map(\.id)) { _, ids in displayedIDs = ids }
             }
         }
         .alert("Error", isPresented: .init(
@@ -48,6 +86,30 @@ struct TodoListView: View {
         } message: {
             Text(errorMessage ?? "")
         }
+        .sheet(item: $timelineProject) { project in
+            ProjectTimelineView(project: project)
+        }
+        .confirmationDialog(
+            "Empty Trash?",
+            isPresented: $showEmptyTrashConfirmation,
+            titleVisibility: .visible
+        ) {
+            Button("Delete Permanently", role: .destructive) {
+                emptyTrash()
+            }
+        } message: {
+            Text("All trashed todos and projects will be permanently deleted. This cannot be undone.")
+        }
+        .permanentDeleteConfirmation(
+            projectPendingDeletion?.name ?? "",
+            isPresented: $showProjectDeleteConfirmation
+        ) {
+            if let project = projectPendingDeletion {
+                serviceContainer!.makeProjectService(context: modelContext)
+                    .permanentlyDelete(project)
+                projectPendingDeletion = nil
+            }
+        }
         .toolbar {
             ToolbarItem(placement: .primaryAction) {
                 Button {
@@ -56,8 +118,96 @@ struct TodoListView: View {
                     Label("Add Todo", systemImage: "plus")
                 }
                 .keyboardShortcut("n", modifiers: .command)
-                .disabled(filter == .trash || filter == .completed)
+                .disabled(!canAddTodos)
+            }
+            ToolbarItem {
+                Menu {
+                    Picker("Group By", selection: $grouping) {
+                        ForEach(TodoGrouping.allCases) { option in
+                            Text(option.label).tag(option)
+                        }
+                    }
+                    .pickerStyle(.inline)
+                    Picker("Sort By", selection: $sort) {
+                        ForEach(TodoSort.allCases) { option in
+                            Text(option.label).tag(option)
+                        }
+                    }
+                    .pickerStyle(.inline)
+                } label: {
+                    Label("View Options", systemImage: "square.stack.3d.up")
+                }
+                .help("Group and sort todos")
+            }
+            if case .project(let project) = filter {
+                ToolbarItem {
+                    Button {
+                        timelineProject = project
+                    } label: {
+                        Label("Timeline", systemImage: "chart.bar.xaxis")
+                    }
+                    .help("Show project timeline")
+                }
+            }
+            if filter == .trash {
+                ToolbarItem {
+                    Button {
+                        showEmptyTrashConfirmation = true
+                    } label: {
+                        Label("Empty Trash", systemImage: "trash.slash")
+                    }
+                    .disabled(filteredTodos.isEmpty && trashedProjects.isEmpty)
+                }
+            }
+            if filter == .completed {
+                ToolbarItem {
+                    Button {
+                        archiveOldCompleted()
+                    } label: {
+                        Label("Archive Old", systemImage: "archivebox")
+                    }
+                    .help("Archive todos completed more than \(AppConfig.archiveAfterDays) days ago")
+                }
+            }
+        }
+    }
+
+    private func todoRows(_ todos: [Todo]) -> some View {
+        ForEach(todos) { todo in
+            TodoRow(todo: todo)
+                .tag(todo)
+                .id(todo.id)
+                .onAppear { visibleRows.insert(todo.id) }
+                .onDisappear { visibleRows.remove(todo.id) }
+        }
+    }
+
+    private func groupHeader(_ group: TodoGroup) -> some View {
+        Button {
+            if collapsedGroups.contains(group.id) {
+                collapsedGroups.remove(group.id)
+            } else {
+                collapsedGroups.insert(group.id)
+            }
+        } label: {
+            HStack(spacing: 4) {
+                Image(systemName: "chevron.right")
+                    .rotationEffect(.degrees(collapsedGroups.contains(group.id) ? 0 : 90))
+                    .font(.caption2)
+                Text(group.title)
+                Text("\(group.todos.count)")
+                    .foregroundStyle(.secondary)
+                Spacer()
             }
+            .contentShape(Rectangle())
+        }
+        .buttonStyle(.plain)
+    }
+
+    private var canAddTodos: Bool {
+        switch filter {
+        case .trash, .completed, .snoozed, .archive: false
+        default: true
         }
     }
 
@@ -66,16 +216,39 @@ struct TodoListView: View {
             switch filter {
             case .all:
                 return try todoService.list(
-                    isCompleted: false, searchText: searchText
+                    isCompleted: false, kind: .task, isSnoozed: false,
+                    searchText: searchText
+                )
+            case .reference:
+                return try todoService.list(
+                    isCompleted: false, kind: .reference, isSnoozed: false,
+                    searchText: searchText
+                )
+            case .snoozed:
+                return try todoService.list(
+                    isCompleted: false, isSnoozed: true, searchText: searchText
                 )
             case .project(let project):
                 return try todoService.list(
-                    project: project, isCompleted: false, searchText: searchText
+                    project: project, isCompleted: false, isSnoozed: false,
+                    searchText: searchText
+                )
+            case .tag(let tag):
+                return try todoService.list(
+                    tag: tag, isCompleted: false, isSnoozed: false,
+                    searchText: searchText
                 )
             case .completed:
                 return try todoService.list(
                     isCompleted: true, searchText: searchText
                 )
+            case .archive:
+                if searchText.isEmpty {
+                    return try todoService.listArchived()
+                }
+                return try todoService.listArchived().filter {
+                    $0.title.localizedCaseInsensitiveContains(searchText)
+                }
             case .trash:
                 if searchText.isEmpty {
                     return try todoService.listTrashed()
@@ -106,7 +279,7 @@ struct TodoListView: View {
                     .foregroundStyle(.quaternary)
                 Text(emptyStateMessage)
                     .foregroundStyle(.secondary)
-                if filter != .trash && filter != .completed {
+                if canAddTodos {
                     Button("Create Todo") {
                         isAddingTodo = true
                     }
@@ -120,29 +293,93 @@ struct TodoListView: View {
     }
 
     private var newTodoField: some View {
-        HStack(spacing: 10) {
-            Image(systemName: "circle")
-                .foregroundStyle(.secondary)
-                .font(.title3)
-
-            TextField("New todo title...", text: $newTodoTitle)
-                .textFieldStyle(.plain)
-                .onSubmit {
-                    createTodo()
-                }
-                .onExitCommand {
-                    isAddingTodo = false
-                    newTodoTitle = ""
-                }
+        VStack(alignment: .leading, spacing: 4) {
+            HStack(spacing: 10) {
+                Image(systemName: "circle")
+                    .foregroundStyle(.secondary)
+                    .font(.title3)
+
+                TextField("New todo  #project @tag !p1 due:next-fri", text: $newTodoTitle)
+                    .textFieldStyle(.plain)
+                    .onSubmit {
+                        createTodo()
+                    }
+                    .onExitCommand {
+                        isAddingTodo = false
+                        newTodoTitle = ""
+                    }
+            }
+
+            let parsed = QuickAddParser.parse(newTodoTitle)
+            if parsed.hasMetadata {
+                quickAddPreview(parsed)
+                    .padding(.leading, 30)
+            }
+            if let duplicate = possibleDuplicate(of: parsed.title) {
+                duplicateHint(duplicate)
+                    .padding(.leading, 30)
+            }
         }
         .padding(.vertical, 4)
     }
 
+    private func duplicateHint(_ duplicate: Todo) -> some View {
+        HStack(spacing: 6) {
+            Image(systemName: "doc.on.doc")
+            Text("Possible duplicate: \(duplicate.title)")
+                .lineLimit(1)
+            Button("Open Existing") {
+                isAddingTodo = false
+                newTodoTitle = ""
+                selectedTodo = duplicate
+            }
+            .buttonStyle(.link)
+        }
+        .font(.caption)
+        .foregroundStyle(.orange)
+    }
+
+    /// The open todo whose title is most similar to `title`, if any is close enough.
+    private func possibleDuplicate(of title: String) -> Todo? {
+        guard title.count >= 4 else { return nil }
+        return openTodos
+            .map { (todo: $0, score: FuzzyMatcher.similarity(title, $0.title)) }
+            .filter { $0.score >= Self.duplicateThreshold }
+            .max { $0.score < $1.score }?
+            .todo
+    }
+
+    private static let duplicateThreshold = 0.6
+
+    private func quickAddPreview(_ parsed: QuickAddResult) -> some View {
+        HStack(spacing: 8) {
+            if let projectName = parsed.projectName {
+                Label(projectName, systemImage: "folder")
+            }
+            ForEach(parsed.tagNames, id: \.self) { tag in
+                Label(tag, systemImage: "tag")
+            }
+            if let priority = parsed.priority {
+                Label(priority.label, systemImage: "flag")
+            }
+            if let dueDate = parsed.dueDate {
+                Label(Formatters.mediumDate.string(from: dueDate), systemImage: "calendar")
+            }
+        }
+        .font(.caption)
+        .foregroundStyle(.secondary)
+        .labelStyle(.titleAndIcon)
+    }
+
     private var emptyStateIcon: String {
         switch filter {
         case .all: "checklist"
+        case .reference: "bookmark"
+        case .snoozed: "moon.zzz"
         case .project: "folder"
+        case .tag: "tag"
         case .completed: "checkmark.circle"
+        case .archive: "archivebox"
         case .trash: "trash"
         }
     }
@@ -151,26 +388,147 @@ struct TodoListView: View {
         if !searchText.isEmpty { return "No matching todos" }
         switch filter {
         case .all: return "No todos yet"
+        case .reference: return "No reference items"
+        case .snoozed: return "Nothing snoozed"
         case .project: return "No todos in this project"
+        case .tag: return "No todos with this tag"
         case .completed: return "No completed todos"
+        case .archive: return "Archive is empty"
         case .trash: return "Trash is empty"
         }
     }
 
+    private func trashedProjectRow(_ project: Project) -> some View {
+        HStack(spacing: 10) {
+            ProjectChip(project: project)
+            Spacer()
+            if let deletedAt = project.deletedAt {
+                Text(deletedAt, style: .relative)
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+            }
+        }
+        .padding(.vertical, 4)
+        .contextMenu {
+            let projectService = serviceContainer!.makeProjectService(context: modelContext)
+            Button("Restore Project") {
+                do {
+                    try projectService.restore(project)
+                } catch {
+                    errorMessage = error.localizedDescription
+                }
+            }
+            Button("Delete Permanently", role: .destructive) {
+                projectPendingDeletion = project
+                showProjectDeleteConfirmation = true
+            }
+        }
+    }
+
+    private func emptyTrash() {
+        let projectService = serviceContainer!.makeProjectService(context: modelContext)
+        do {
+            for project in trashedProjects {
+                projectService.permanentlyDelete(project)
+            }
+            for todo in try todoService.listTrashed() {
+                if selectedTodo?.id == todo.id {
+                    selectedTodo = nil
+                }
+                todoService.permanentlyDelete(todo)
+            }
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+
+    private func archiveOldCompleted() {
+        do {
+            _ = try todoService.archiveCompleted(olderThanDays: AppConfig.archiveAfterDays)
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+
+    /// Matches an existing project case-insensitively, ignoring spaces
+    /// (`#MyProject` finds "My Project"), or creates it.
+    private func resolveProject(named name: String) throws -> Project {
+        let projectService = serviceContainer!.makeProjectService(context: modelContext)
+        let key = Self.matchKey(name)
+        if let existing = try projectService.list().first(where: { Self.matchKey($0.name) == key }) {
+            return existing
+        }
+        return try projectService.create(name: name)
+    }
+
+    private func resolveTag(named name: String) throws -> Tag {
+        let tagService = serviceContainer!.makeTagService(context: modelContext)
+        let key = Self.matchKey(name)
+        if let existing = try tagService.list().first(where: { Self.matchKey($0.name) == key }) {
+            return existing
+        }
+        return try tagService.create(name: name)
+    }
+
+    private static func matchKey(_ name: String) -> String {
+        name.lowercased().filter { !$0.isWhitespace }
+    }
+
+    /// Records the topmost row on screen for the current filter. Rows are
+    /// looked up in display order since List reports visibility in no order.
+    private func rememberScroll() {
+        guard !isRestoringScroll,
+              let top = displayedIDs.first(where: visibleRows.contains) else { return }
+        scrollAnchors[filter] = top
+    }
+
+    /// Returns to where the list was left, or else brings the selection into
+    /// view. Deferred a turn so the rows of a newly shown filter exist first.
+    private func restoreScroll(_ proxy: ScrollViewProxy) {
+        let anchor = scrollAnchors[filter]
+        let selection = selectedTodo?.id
+        isRestoringScroll = true
+        DispatchQueue.main.async {
+            if let anchor {
+                proxy.scrollTo(anchor, anchor: .top)
+            } else if let selection {
+                proxy.scrollTo(selection, anchor: .center)
+            }
+            isRestoringScroll = false
+        }
+    }
+
     private func createTodo() {
-        let title = newTodoTitle.trimmingCharacters(in: .whitespacesAndNewlines)
-        guard !title.isEmpty else {
+        let input = newTodoTitle.trimmingCharacters(in: .whitespacesAndNewlines)
+        guard !input.isEmpty else {
             isAddingTodo = false
             newTodoTitle = ""
             return
         }
 
+        let parsed = QuickAddParser.parse(input)
         var project: Project? = nil
         if case .project(let p) = filter {
             project = p
         }
         do {
-            let todo = try todoService.create(title: title, project: project)
+            if let projectName = parsed.projectName {
+                project = try resolveProject(named: projectName)
+            }
+            var tags = try parsed.tagNames.map(resolveTag(named:))
+            if case .tag(let filterTag) = filter, !tags.contains(where: { $0.id == filterTag.id }) {
+                tags.append(filterTag)
+            }
+            let todo = try todoService.create(
+                title: parsed.title,
+                priority: parsed.priority ?? .medium,
+                dueDate: parsed.dueDate,
+                project: project,
+                tags: tags
+            )
+            if filter == .reference {
+                todoService.setKind(todo, kind: .reference)
+            }
             selectedTodo = todo
         } catch {
             errorMessage = error.localizedDescription
@@ -5,6 +5,11 @@ struct TodoRow: View {
     @Environment(\.serviceContainer) private var serviceContainer
     let todo: Todo
 
+    @State private var showBlockedWarning = false
+    @State private var showPermanentDeleteConfirmation = false
+    @AppStorage(AppConfig.Keys.todoRowFields)
+    private var rowFields = AppConfig.Defaults.todoRowFields
+
     private var todoService: any TodoServiceProtocol {
         serviceContainer!.makeTodoService(context: modelContext)
     }
@@ -12,7 +17,11 @@ struct TodoRow: View {
     var body: some View {
         HStack(spacing: 10) {
             Button {
-                todoService.toggleComplete(todo)
+                if todo.needsCompletionConfirmation {
+                    showBlockedWarning = true
+                } else {
+                    todoService.toggleComplete(todo)
+                }
             } label: {
                 Image(systemName: todo.isCompleted ? "checkmark.circle.fill" : "circle")
                     .foregroundStyle(todo.isCompleted ? .green : .secondary)
@@ -27,38 +36,34 @@ struct TodoRow: View {
                         .strikethrough(todo.isCompleted)
                         .foregroundStyle(todo.isCompleted ? .secondary : .primary)
 
-                    priorityBadge
-                }
+                    if fields.contains(.priority) {
+                        priorityBadge
+                    }
 
-                HStack(spacing: 6) {
-                    if let project = todo.project {
-                        HStack(spacing: 3) {
-                            Circle()
-                                .fill(Color(hex: project.color) ?? .blue)
-                                .frame(width: 6, height: 6)
-                            Text(project.name)
-                        }
-                        .font(.caption)
-                        .foregroundStyle(.secondary)
+                    if todo.isBlocked && !todo.isCompleted {
+                        Image(systemName: "lock.fill")
+                            .font(.caption2)
+                            .foregroundStyle(.orange)
+                            .help("Blocked by \(todo.openBlockers.map(\.title).joined(separator: ", "))")
+                    }
+
+                    if todo.isReference {
+                        Image(systemName: "bookmark.fill")
+                            .font(.caption2)
+                            .foregroundStyle(.secondary)
                     }
 
-                    ForEach(todo.tags) { tag in
-                        Text(tag.name)
+                    if todo.isSnoozed, let snoozedUntil = todo.snoozedUntil {
+                        Image(systemName: "moon.zzz.fill")
                             .font(.caption2)
-                            .padding(.horizontal, 5)
-                            .padding(.vertical, 1)
-                            .background(Color(hex: tag.color)?.opacity(0.2) ?? .gray.opacity(0.2),
-                                        in: Capsule())
-                            .foregroundStyle(Color(hex: tag.color) ?? .gray)
+                            .foregroundStyle(.indigo)
+                            .help("Snoozed until \(Formatters.dateTime.string(from: snoozedUntil))")
                     }
+                }
 
-                    if let dueDate = todo.dueDate {
-                        HStack(spacing: 2) {
-                            Image(systemName: "calendar")
-                            Text(dueDate, style: .date)
-                        }
-                        .font(.caption)
-                        .foregroundStyle(dueDate < Date() && !todo.isCompleted ? .red : .secondary)
+                HStack(spacing: 6) {
+                    ForEach(fields.filter { $0 != .priority }) { field in
+                        detail(field)
                     }
                 }
             }
@@ -67,6 +72,89 @@ struct TodoRow: View {
         }
         .padding(.vertical, 4)
         .contentShape(Rectangle())
+        .blockedCompletionDialog(for: todo, isPresented: $showBlockedWarning) {
+            todoService.complete(todo)
+        }
+        .permanentDeleteConfirmation(todo.title, isPresented: $showPermanentDeleteConfirmation) {
+            todoService.permanentlyDelete(todo)
+        }
+        .contextMenu {
+            if todo.isTrashed {
+                Button("Restore") {
+                    todoService.restore(todo)
+                }
+                Button("Delete Permanently", role: .destructive) {
+                    showPermanentDeleteConfirmation = true
+                }
+                Divider()
+            }
+
+            TodoLinkMenu(todo: todo)
+
+            if todo.isActive {
+                Divider()
+                SnoozeMenu(todo: todo)
+            }
+        }
+    }
+
+    private var fields: [TodoRowField] {
+        AppConfig.parseRowFields(rowFields)
+    }
+
+    @ViewBuilder
+    private func detail(_ field: TodoRowField) -> some View {
+        switch field {
+        case .project:
+            if let project = todo.project {
+                ProjectChip(project: project)
+                    .font(.caption)
+                    .foregroundStyle(.secondary)
+            }
+        case .tags:
+            ForEach(todo.tags) { tag in
+                TagChip(tag: tag)
+                    .font(.caption2)
+            }
+        case .dueDate:
+            if let dueDate = todo.dueDate {
+                let days = Formatters.daysUntil(dueDate)
+                HStack(spacing: 2) {
+                    Image(systemName: "calendar")
+                    Text(todo.isCompleted
+                        ? Formatters.mediumDate.string(from: dueDate)
+                        : Formatters.relativeDue(dueDate))
+                }
+                .font(.caption)
+                .fontWeight(!todo.isCompleted && days <= -7 ? .semibold : .regular)
+                .foregroundStyle(todo.isCompleted ? .secondary : dueColor(days: days))
+                .help(Formatters.mediumDate.string(from: dueDate))
+            }
+        case .jira:
+            if let ticketID = todo.jiraLink?.ticketID {
+                Text(ticketID)
+                    .font(.system(.caption, design: .monospaced))
+                    .foregroundStyle(.blue)
+            }
+        case .age:
+            let days = Calendar.current.dateComponents([.day], from: todo.createdAt, to: Date()).day ?? 0
+            Text(days == 0 ? "new" : "\(days)d old")
+                .font(.caption)
+                .foregroundStyle(.tertiary)
+                .help("Created \(Formatters.dateTime.string(from: todo.createdAt))")
+        case .priority:
+            priorityBadge
+        }
+    }
+
+    /// Escalates from neutral to orange as the due date nears, then red once overdue.
+    private func dueColor(days: Int) -> Color {
+        switch days {
+        case ..<0: .red
+        case 0: .orange
+        case 1...2: .yellow
+        default: .secondary
+        }
     }
 
     @ViewBuilder
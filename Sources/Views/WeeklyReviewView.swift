@@ -0,0 +1,165 @@
+import SwiftUI
+import SwiftData
+import AppKit
+import UniformTypeIdentifiers
+
+/// Sheet summarizing a week, with Markdown copy and export.
+struct WeeklyReviewView: View {
+    @Environment(\.modelContext) private var modelContext
+    @Environment(\.dismiss) private var dismiss
+
+    @State private var weekStart = Date()
+    @State private var review: WeeklyReview?
+    @State private var errorMessage: String?
+
+    var body: some View {
+        VStack(spacing: 0) {
+            header
+            Divider()
+
+            if let review {
+                ScrollView {
+                    VStack(alignment: .leading, spacing: 16) {
+                        todoSection("Completed", todos: review.completed, icon: "checkmark.circle.fill", color: .green)
+                        todoSection("Created", todos: review.created, icon: "plus.circle", color: .blue)
+                        todoSection("In Progress", todos: review.inProgress, icon: "circle.lefthalf.filled", color: .orange)
+                        todoSection("Overdue", todos: review.overdue, icon: "exclamationmark.circle", color: .red)
+                        todoSection("Upcoming", todos: review.upcoming, icon: "calendar", color: .teal)
+                        todoSection("Blocked", todos: review.blocked, icon: "lock.fill", color: .orange)
+                        todoSection("Completed with Pull Requests", todos: review.completedWithPullRequests, icon: "arrow.triangle.pull", color: .purple)
+                        timeSection(review)
+                    }
+                    .padding()
+                    .frame(maxWidth: .infinity, alignment: .leading)
+                }
+            } else {
+                ProgressView()
+                    .frame(maxWidth: .infinity, maxHeight: .infinity)
+            }
+
+            Divider()
+
+            HStack {
+                Button("Copy as Markdown") {
+                    guard let review else { return }
+                    NSPasteboard.general.clearContents()
+                    NSPasteboard.general.setString(review.markdown, forType: .string)
+                }
+                Button("Export…") { export() }
+                Spacer()
+                Button("Done") { dismiss() }
+                    .keyboardShortcut(.defaultAction)
+            }
+            .disabled(review == nil)
+            .padding()
+        }
+        .frame(width: 520, height: 560)
+        .onAppear { load() }
+        .onChange(of: weekStart) { load() }
+        .alert("Error", isPresented: .init(
+            get: { errorMessage != nil },
+            set: { if !$0 { errorMessage = nil } }
+        )) {
+            Button("OK") { errorMessage = nil }
+        } message: {
+            Text(errorMessage ?? "")
+        }
+    }
+
+    private var header: some View {
+        HStack {
+            Button {
+                shiftWeek(by: -1)
+            } label: {
+                Image(systemName: "chevron.left")
+            }
+            Spacer()
+            Text(review?.title ?? "Weekly Review")
+                .font(.headline)
+            Spacer()
+            Button {
+                shiftWeek(by: 1)
+            } label: {
+                Image(systemName: "chevron.right")
+            }
+        }
+        .buttonStyle(.borderless)
+        .padding()
+    }
+
+    private func todoSection(_ title: String, todos: [Todo], icon: String, color: Color) -> some View {
+        VStack(alignment: .leading, spacing: 6) {
+            Text("\(title) (\(todos.count))")
+                .font(.subheadline.bold())
+            if todos.isEmpty {
+                Text("None")
+                    .font(.callout)
+                    .foregroundStyle(.tertiary)
+            }
+            ForEach(todos) { todo in
+                HStack(spacing: 6) {
+                    Image(systemName: icon)
+                        .foregroundStyle(color)
+                    Text(todo.title)
+                        .lineLimit(1)
+                    if let project = todo.project {
+                        ProjectChip(project: project)
+                            .font(.caption)
+                            .foregroundStyle(.secondary)
+                    }
+                }
+                .font(.callout)
+            }
+        }
+    }
+
+    private func timeSection(_ review: WeeklyReview) -> some View {
+        VStack(alignment: .leading, spacing: 6) {
+            Text("Time (\(review.totalTime.hoursMinutes))")
+                .font(.subheadline.bold())
+            if review.timeByProject.isEmpty {
+                Text("None")
+                    .font(.callout)
+                    .foregroundStyle(.tertiary)
+            }
+            ForEach(review.timeByProject, id: \.project) { row in
+                HStack {
+                    Text(row.project)
+                    Spacer()
+                    Text(row.duration.hoursMinutes)
+                        .foregroundStyle(.secondary)
+                        .monospacedDigit()
+                }
+                .font(.callout)
+            }
+        }
+    }
+
+    private func shiftWeek(by weeks: Int) {
+        weekStart = Calendar.current.date(byAdding: .weekOfYear, value: weeks, to: weekStart) ?? weekStart
+    }
+
+    private func load() {
+        do {
+            review = try WeeklyReview.build(weekContaining: weekStart, context: modelContext)
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+
+    private func export() {
+        guard let review else { return }
+        let panel = NSSavePanel()
+        panel.allowedContentTypes = [UTType(filenameExtension: "md") ?? .plainText]
+        let day = ISO8601DateFormatter.string(
+            from: review.interval.start, timeZone: .current, formatOptions: [.withFullDate]
+        )
+        panel.nameFieldStringValue = "weekly-review-\(day).md"
+        guard panel.runModal() == .OK, let url = panel.url else { return }
+        do {
+            try review.markdown.write(to: url, atomically: true, encoding: .utf8)
+        } catch {
+            errorMessage = error.localizedDescription
+        }
+    }
+}
@@ -0,0 +1,62 @@
+import Foundation
+import Testing
+@testable import TaskManagement
+
+struct BackupServiceTests {
+    private let directory: URL
+
+    init() throws {
+        directory = FileManager.default.temporaryDirectory
+            .appendingPathComponent("BackupServiceTests-\(UUID().uuidString)", isDirectory: true)
+        try FileManager.default.createDirectory(at: directory, withIntermediateDirectories: true)
+    }
+
+    private func touch(_ name: String) {
+        FileManager.default.createFile(atPath: directory.appendingPathComponent(name).path, contents: Data())
+    }
+
+    private var remaining: [String] {
+        let names = (try? FileManager.default.contentsOfDirectory(atPath: directory.path)) ?? []
+        return names.sorted()
+    }
+
+    @Test func listsOnlyBackupsNewestFirst() {
+        touch("TaskManagement-2026-02-10-090000.store")
+        touch("TaskManagement-2026-02-11-090000.store")
+        touch("pending-restore.store")
+        touch("notes.txt")
+
+        let names = BackupService.listBackups(in: directory).map(\.lastPathComponent)
+        #expect(names == [
+            "TaskManagement-2026-02-11-090000.store",
+            "TaskManagement-2026-02-10-090000.store",
+        ])
+    }
+
+    @Test func rotateKeepsTheNewest() {
+        for day in 10...14 {
+            touch("TaskManagement-2026-02-\(day)-090000.store")
+        }
+        touch("pending-restore.store")
+
+        #expect(BackupService.rotate(keep: 2, in: directory) == 3)
+        #expect(remaining == [
+            "TaskManagement-2026-02-13-090000.store",
+            "TaskManagement-2026-02-14-090000.store",
+            "pending-restore.store",
+        ])
+    }
+
+    @Test func rotateAlwaysKeepsOne() {
+        touch("TaskManagement-2026-02-10-090000.store")
+        touch("TaskManagement-2026-02-11-090000.store")
+
+        #expect(BackupService.rotate(keep: 0, in: directory) == 1)
+        #expect(remaining == ["TaskManagement-2026-02-11-090000.store"])
+    }
+
+    @Test func rotateWithFewerBackupsRemovesNothing() {
+        touch("TaskManagement-2026-02-10-090000.store")
+        #expect(BackupService.rotate(keep: 5, in: directory) == 0)
+    }
+}
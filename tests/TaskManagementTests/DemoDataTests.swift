@@ -0,0 +1,50 @@
+import Foundation
+import SwiftData
+import Testing
+@testable import TaskManagement
+
+@MainActor
+struct DemoDataTests {
+    private func makeContainer() throws -> ModelContainer {
+        try ModelContainer(
+            for: TaskManagementApp.schema,
+            configurations: ModelConfiguration(isStoredInMemoryOnly: true)
+        )
+    }
+
+    @Test func seedsProjectsTodosAndTime() throws {
+        let context = try makeContainer().mainContext
+        try DemoData.seed(into: context)
+
+        let projects = try context.fetch(FetchDescriptor<Project>())
+        let todos = try context.fetch(FetchDescriptor<Todo>())
+        let entries = try context.fetch(FetchDescriptor<TimeEntry>())
+        #expect(projects.count == 3)
+        #expect(todos.count == 7)
+        #expect(entries.count == 4)
+        #expect(entries.allSatisfy { $0.todo != nil })
+    }
+
+    @Test func seededDependencyBlocksTodo() throws {
+        let context = try makeContainer().mainContext
+        try DemoData.seed(into: context)
+
+        let todos = try context.fetch(FetchDescriptor<Todo>())
+        let copy = try #require(todos.first { $0.title == "Write copy for the pricing page" })
+        #expect(copy.isBlocked)
+        #expect(copy.openBlockers.map(\.title) == ["Finalize landing page mockups"])
+    }
+
+    @Test func servicesWorkAgainstInMemoryStore() throws {
+        let context = try makeContainer().mainContext
+        try DemoData.seed(into: context)
+        let service = TodoService(context: context)
+
+        let todos = try context.fetch(FetchDescriptor<Todo>())
+        let dentist = try #require(todos.first { $0.title == "Book dentist appointment" })
+        service.complete(dentist)
+
+        #expect(dentist.isCompleted)
+        #expect(dentist.completedAt != nil)
+    }
+}
@@ -0,0 +1,32 @@
+import Testing
+@testable import TaskManagement
+
+struct FuzzyMatcherTests {
+    @Test func identicalTitlesMatchFully() {
+        #expect(FuzzyMatcher.similarity("Update release notes", "Update release notes") == 1)
+    }
+
+    @Test func ignoresCasePunctuationAndSpacing() {
+        #expect(FuzzyMatcher.similarity("Fix login bug!", "fix  login bug") == 1)
+    }
+
+    @Test func nearDuplicatesPassTheHintThreshold() {
+        #expect(FuzzyMatcher.similarity("Update release notes", "Update the release notes") > 0.6)
+        #expect(FuzzyMatcher.similarity("Review PR", "Review PRs") > 0.6)
+    }
+
+    @Test func unrelatedTitlesDoNotMatch() {
+        #expect(FuzzyMatcher.similarity("Write quarterly report", "Book dentist appointment") == 0)
+    }
+
+    @Test func emptyInputHasNoSimilarity() {
+        #expect(FuzzyMatcher.similarity("", "anything") == 0)
+        #expect(FuzzyMatcher.similarity("!!!", "???") == 0)
+    }
+
+    @Test func isSymmetric() {
+        let forward = FuzzyMatcher.similarity("Plan sprint", "Sprint planning")
+        let backward = FuzzyMatcher.similarity("Sprint planning", "Plan sprint")
+        #expect(forward == backward)
+    }
+}
@@ -0,0 +1,72 @@
+import Foundation
+import Testing
+@testable import TaskManagement
+
+struct QuickAddParserTests {
+    private let calendar = Calendar.current
+
+    /// Wednesday, 11 February 2026, mid-morning.
+    private var wednesday: Date {
+        calendar.date(from: DateComponents(year: 2026, month: 2, day: 11, hour: 10))!
+    }
+
+    private func day(_ year: Int, _ month: Int, _ day: Int) -> Date {
+        calendar.date(from: DateComponents(year: year, month: month, day: day, hour: 23, minute: 59))!
+    }
+
+    private func parse(_ value: String, now: Date? = nil) -> Date? {
+        QuickAddParser.parseDueDate(value, now: now ?? wednesday)
+    }
+
+    @Test func relativeOffsets() {
+        #expect(parse("in 3 days") == day(2026, 2, 14))
+        #expect(parse("in 1 day") == day(2026, 2, 12))
+        #expect(parse("in 2 weeks") == day(2026, 2, 25))
+        #expect(parse("in 1 month") == day(2026, 3, 11))
+        #expect(parse("next week") == day(2026, 2, 18))
+        #expect(parse("next month") == day(2026, 3, 11))
+    }
+
+    @Test func wordsMayBeJoinedWithDashesOrUnderscores() {
+        #expect(parse("in-2-weeks") == day(2026, 2, 25))
+        #expect(parse("next_week") == day(2026, 2, 18))
+        #expect(parse("end-of-month") == day(2026, 2, 28))
+        #expect(parse("Next-Fri") == parse("next fri"))
+    }
+
+    @Test func nextWeekdaySkipsTheCurrentWeek() {
+        // This week's Friday is the 13th, so "next fri" is the one after
+        #expect(parse("fri") == day(2026, 2, 13))
+        #expect(parse("next fri") == day(2026, 2, 20))
+    }
+
+    @Test func nextWeekdayFromTheWeekendIsTheComingOne() {
+        let saturday = calendar.date(from: DateComponents(year: 2026, month: 2, day: 14, hour: 10))!
+        #expect(parse("next fri", now: saturday) == day(2026, 2, 20))
+    }
+
+    @Test func endOfMonth() {
+        #expect(parse("end of month") == day(2026, 2, 28))
+        #expect(parse("eom") == day(2026, 2, 28))
+    }
+
+    @Test func endOfWeekIsTheLastDayOfThisWeek() throws {
+        let date = try #require(parse("end of week"))
+        let week = try #require(calendar.dateInterval(of: .weekOfYear, for: wednesday))
+        #expect(week.contains(date))
+        let nextDay = try #require(calendar.date(byAdding: .day, value: 1, to: date))
+        #expect(!week.contains(nextDay))
+        #expect(parse("eow") == date)
+    }
+
+    @Test func isoDates() {
+        #expect(parse("2026-03-05") == day(2026, 3, 5))
+    }
+
+    @Test func rejectsUnknownInput() {
+        #expect(parse("someday") == nil)
+        #expect(parse("in three days") == nil)
+        #expect(parse("in 2 fortnights") == nil)
+        #expect(parse("next blursday") == nil)
+    }
+}
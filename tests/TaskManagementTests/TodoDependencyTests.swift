@@ -0,0 +1,78 @@
+import Foundation
+import SwiftData
+import Testing
+@testable import TaskManagement
+
+@MainActor
+struct TodoDependencyTests {
+    private let context: ModelContext
+    private let service: TodoService
+
+    init() throws {
+        let container = try ModelContainer(
+            for: TaskManagementApp.schema,
+            configurations: ModelConfiguration(isStoredInMemoryOnly: true)
+        )
+        context = container.mainContext
+        service = TodoService(context: context)
+    }
+
+    private func insert(_ title: String) -> Todo {
+        let todo = Todo(title: title)
+        context.insert(todo)
+        return todo
+    }
+
+    @Test func blockingIsTransitive() throws {
+        let design = insert("Design")
+        let build = insert("Build")
+        let ship = insert("Ship")
+        try service.addBlocker(design, to: build)
+        try service.addBlocker(build, to: ship)
+
+        #expect(TodoService.isBlocked(ship, by: build))
+        #expect(TodoService.isBlocked(ship, by: design))
+        #expect(!TodoService.isBlocked(design, by: ship))
+    }
+
+    @Test func rejectsSelfDependency() {
+        let todo = insert("Solo")
+        #expect(throws: ValidationError.self) {
+            try service.addBlocker(todo, to: todo)
+        }
+        #expect(todo.blockers.isEmpty)
+    }
+
+    @Test func rejectsCycles() throws {
+        let first = insert("First")
+        let second = insert("Second")
+        let third = insert("Third")
+        try service.addBlocker(first, to: second)
+        try service.addBlocker(second, to: third)
+
+        #expect(throws: ValidationError.self) {
+            try service.addBlocker(third, to: first)
+        }
+        #expect(first.blockers.isEmpty)
+    }
+
+    @Test func addingTheSameBlockerTwiceIsANoOp() throws {
+        let blocker = insert("Blocker")
+        let todo = insert("Todo")
+        try service.addBlocker(blocker, to: todo)
+        try service.addBlocker(blocker, to: todo)
+        #expect(todo.blockers.count == 1)
+    }
+
+    @Test func toleratesExistingCycles() throws {
+        // Data from before cycle checks existed may already loop
+        let left = insert("Left")
+        let right = insert("Right")
+        left.blockers.append(right)
+        right.blockers.append(left)
+        let outsider = insert("Outsider")
+
+        #expect(TodoService.isBlocked(left, by: right))
+        #expect(!TodoService.isBlocked(left, by: outsider))
+    }
+}
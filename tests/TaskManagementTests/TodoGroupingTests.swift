@@ -0,0 +1,88 @@
+import Foundation
+import SwiftData
+import Testing
+@testable import TaskManagement
+
+@MainActor
+struct TodoGroupingTests {
+    private let context: ModelContext
+    private let calendar = Calendar.current
+    private let now: Date
+
+    init() throws {
+        let container = try ModelContainer(
+            for: TaskManagementApp.schema,
+            configurations: ModelConfiguration(isStoredInMemoryOnly: true)
+        )
+        context = container.mainContext
+        now = Calendar.current.date(from: DateComponents(year: 2026, month: 2, day: 11, hour: 10))!
+    }
+
+    private func insert(
+        _ title: String,
+        priority: Priority = .medium,
+        dueInDays: Int? = nil,
+        project: Project? = nil
+    ) -> Todo {
+        let due = dueInDays.flatMap {
+            calendar.date(byAdding: .day, value: $0, to: calendar.startOfDay(for: now))
+        }
+        let todo = Todo(title: title, priority: priority, dueDate: due, project: project)
+        context.insert(todo)
+        return todo
+    }
+
+    @Test func noneIsASingleGroup() {
+        let todos = [insert("A"), insert("B")]
+        let groups = TodoGrouping.none.groups(todos, now: now)
+        #expect(groups.map(\.id) == ["all"])
+        #expect(groups.first?.todos.count == 2)
+    }
+
+    @Test func projectGroupsFollowProjectOrderWithLooseTodosLast() {
+        let work = Project(name: "Work", sortOrder: 1)
+        let home = Project(name: "Home", sortOrder: 0)
+        context.insert(work)
+        context.insert(home)
+        let todos = [
+            insert("Loose"),
+            insert("Report", project: work),
+            insert("Laundry", project: home),
+            insert("Review", project: work),
+        ]
+
+        let groups = TodoGrouping.project.groups(todos, now: now)
+        #expect(groups.map(\.title) == ["Home", "Work", "No Project"])
+        #expect(groups.map(\.id) == [
+            "project.\(home.id.uuidString)", "project.\(work.id.uuidString)", "project.none",
+        ])
+        #expect(groups[1].todos.map(\.title) == ["Report", "Review"])
+    }
+
+    @Test func projectNamedNoProjectKeepsItsOwnID() {
+        let named = Project(name: "No Project")
+        context.insert(named)
+        let groups = TodoGrouping.project.groups([insert("Loose"), insert("Named", project: named)], now: now)
+        #expect(Set(groups.map(\.id)).count == 2)
+    }
+
+    @Test func priorityGroupsSkipEmptyPriorities() {
+        let todos = [insert("Low", priority: .low), insert("High", priority: .high)]
+        let groups = TodoGrouping.priority.groups(todos, now: now)
+        #expect(groups.map(\.id) == ["priority.high", "priority.low"])
+    }
+
+    @Test func dueDateBuckets() {
+        let todos = [
+            insert("Later", dueInDays: 10),
+            insert("Overdue", dueInDays: -1),
+            insert("None"),
+            insert("Today", dueInDays: 0),
+            insert("Soon", dueInDays: 3),
+        ]
+        let groups = TodoGrouping.dueDate.groups(todos, now: now)
+        #expect(groups.map(\.title) == ["Overdue", "Today", "Next 7 Days", "Later", "No Due Date"])
+        #expect(groups.map(\.id) == ["due.0", "due.1", "due.2", "due.3", "due.4"])
+        #expect(groups.map { $0.todos.map(\.title) } == [["Overdue"], ["Today"], ["Soon"], ["Later"], ["None"]])
+    }
+}
@@ -0,0 +1,57 @@
+import Foundation
+import SwiftData
+import Testing
+@testable import TaskManagement
+
+@MainActor
+struct TodoSortTests {
+    private let context: ModelContext
+    private let calendar = Calendar.current
+
+    init() throws {
+        let container = try ModelContainer(
+            for: TaskManagementApp.schema,
+            configurations: ModelConfiguration(isStoredInMemoryOnly: true)
+        )
+        context = container.mainContext
+    }
+
+    private func insert(_ title: String, priority: Priority = .medium, dueInDays: Int? = nil) -> Todo {
+        let due = dueInDays.flatMap {
+            calendar.date(byAdding: .day, value: $0, to: calendar.startOfDay(for: Date()))
+        }
+        let todo = Todo(title: title, priority: priority, dueDate: due)
+        context.insert(todo)
+        return todo
+    }
+
+    @Test func manualKeepsTheGivenOrder() {
+        let todos = [insert("B"), insert("A"), insert("C")]
+        #expect(TodoSort.manual.sorted(todos).map(\.title) == ["B", "A", "C"])
+    }
+
+    @Test func dueDatePutsUndatedLastAndIsStable() {
+        let todos = [
+            insert("Undated 1"),
+            insert("Friday", dueInDays: 2),
+            insert("Today A", dueInDays: 0),
+            insert("Undated 2"),
+            insert("Today B", dueInDays: 0),
+        ]
+        #expect(TodoSort.dueDate.sorted(todos).map(\.title) == [
+            "Today A", "Today B", "Friday", "Undated 1", "Undated 2",
+        ])
+    }
+
+    @Test func priorityIsHighestFirstAndStable() {
+        let todos = [
+            insert("Low", priority: .low),
+            insert("Medium 1"),
+            insert("High", priority: .high),
+            insert("Medium 2"),
+        ]
+        #expect(TodoSort.priority.sorted(todos).map(\.title) == [
+            "High", "Medium 1", "Medium 2", "Low",
+        ])
+    }
+}